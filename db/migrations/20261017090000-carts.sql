@@ -0,0 +1,30 @@
+
+-- +migrate Up
+CREATE TYPE "cart_status" AS ENUM ('active', 'checked_out');
+
+CREATE TABLE carts (
+    "id" SERIAL PRIMARY KEY,
+    "user_id" INTEGER NOT NULL,
+    "status" cart_status NOT NULL DEFAULT 'active',
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX carts_active_user_id_idx ON carts (user_id) WHERE status = 'active';
+
+CREATE TABLE cart_items (
+    "id" SERIAL PRIMARY KEY,
+    "cart_id" INTEGER NOT NULL REFERENCES carts(id) ON DELETE CASCADE,
+    "product_id" INTEGER NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "unit_price" BIGINT NOT NULL,
+    "quantity" BIGINT NOT NULL CHECK (quantity > 0),
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (cart_id, product_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS cart_items;
+DROP TABLE IF EXISTS carts;
+DROP TYPE IF EXISTS cart_status;
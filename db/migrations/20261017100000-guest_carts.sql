@@ -0,0 +1,18 @@
+
+-- +migrate Up notransaction
+ALTER TYPE "cart_status" ADD VALUE IF NOT EXISTS 'merged';
+ALTER TYPE "cart_status" ADD VALUE IF NOT EXISTS 'expired';
+
+ALTER TABLE carts ALTER COLUMN "user_id" DROP NOT NULL;
+ALTER TABLE carts ADD COLUMN "guest_token" VARCHAR(64) NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS carts_guest_token_idx ON carts (guest_token);
+ALTER TABLE carts ADD CONSTRAINT carts_owner_check CHECK (user_id IS NOT NULL OR guest_token IS NOT NULL);
+
+-- +migrate Down
+ALTER TABLE carts DROP CONSTRAINT IF EXISTS carts_owner_check;
+DROP INDEX IF EXISTS carts_guest_token_idx;
+ALTER TABLE carts DROP COLUMN IF EXISTS "guest_token";
+-- Guest carts have no owner once their token is gone; their items go with them.
+DELETE FROM carts WHERE user_id IS NULL;
+ALTER TABLE carts ALTER COLUMN "user_id" SET NOT NULL;
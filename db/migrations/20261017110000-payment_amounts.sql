@@ -0,0 +1,16 @@
+
+-- +migrate Up
+ALTER TABLE payments
+    ADD COLUMN "amount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "subtotal_amount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "discount_amount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "tax_amount" BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN "shipping_amount" BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS "amount",
+    DROP COLUMN IF EXISTS "subtotal_amount",
+    DROP COLUMN IF EXISTS "discount_amount",
+    DROP COLUMN IF EXISTS "tax_amount",
+    DROP COLUMN IF EXISTS "shipping_amount";
@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE payments ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "currency";
@@ -0,0 +1,45 @@
+
+-- +migrate Up
+CREATE TYPE "coupon_type" AS ENUM ('percentage', 'fixed_amount', 'free_shipping');
+
+CREATE TABLE coupons (
+    "id" SERIAL PRIMARY KEY,
+    "code" VARCHAR(64) NOT NULL,
+    "type" coupon_type NOT NULL,
+    "value" BIGINT NOT NULL DEFAULT 0,
+    "max_discount" BIGINT NOT NULL DEFAULT 0,
+    "min_cart_value" BIGINT NOT NULL DEFAULT 0,
+    "usage_limit" BIGINT NOT NULL DEFAULT 0,
+    "per_user_limit" BIGINT NOT NULL DEFAULT 0,
+    "used_count" BIGINT NOT NULL DEFAULT 0,
+    "starts_at" TIMESTAMP NULL,
+    "ends_at" TIMESTAMP NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX coupons_code_idx ON coupons (UPPER(code)) WHERE deleted_at IS NULL;
+
+CREATE TABLE coupon_redemptions (
+    "id" SERIAL PRIMARY KEY,
+    "coupon_id" INTEGER NOT NULL REFERENCES coupons(id),
+    "user_id" INTEGER NOT NULL,
+    "payment_id" INTEGER NOT NULL REFERENCES payments(id),
+    "order_id" VARCHAR(100) NOT NULL,
+    "discount_amount" BIGINT NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (payment_id)
+);
+
+CREATE INDEX coupon_redemptions_coupon_user_idx ON coupon_redemptions (coupon_id, user_id);
+
+ALTER TABLE carts ADD COLUMN "coupon_code" VARCHAR(64) NULL;
+ALTER TABLE payments ADD COLUMN "coupon_code" VARCHAR(64) NULL;
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "coupon_code";
+ALTER TABLE carts DROP COLUMN IF EXISTS "coupon_code";
+DROP TABLE IF EXISTS coupon_redemptions;
+DROP TABLE IF EXISTS coupons;
+DROP TYPE IF EXISTS coupon_type;
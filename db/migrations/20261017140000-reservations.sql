@@ -0,0 +1,23 @@
+
+-- +migrate Up
+CREATE TYPE "reservation_status" AS ENUM ('active', 'committed', 'released');
+
+CREATE TABLE reservations (
+    "id" SERIAL PRIMARY KEY,
+    "cart_id" INTEGER NOT NULL REFERENCES carts(id),
+    "order_id" VARCHAR(100) NULL,
+    "product_id" INTEGER NOT NULL,
+    "quantity" BIGINT NOT NULL CHECK (quantity > 0),
+    "status" reservation_status NOT NULL DEFAULT 'active',
+    "expires_at" TIMESTAMP NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX reservations_product_active_idx ON reservations (product_id) WHERE status = 'active';
+CREATE INDEX reservations_order_id_idx ON reservations (order_id);
+CREATE INDEX reservations_expires_at_idx ON reservations (expires_at) WHERE status = 'active';
+
+-- +migrate Down
+DROP TABLE IF EXISTS reservations;
+DROP TYPE IF EXISTS reservation_status;
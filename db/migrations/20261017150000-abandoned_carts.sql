@@ -0,0 +1,8 @@
+
+-- +migrate Up notransaction
+ALTER TYPE "cart_status" ADD VALUE IF NOT EXISTS 'abandoned';
+
+CREATE INDEX IF NOT EXISTS carts_active_updated_at_idx ON carts (updated_at) WHERE status = 'active' AND user_id IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS carts_active_updated_at_idx;
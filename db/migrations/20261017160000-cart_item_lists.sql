@@ -0,0 +1,15 @@
+
+-- +migrate Up
+CREATE TYPE "cart_list_type" AS ENUM ('cart', 'saved_for_later', 'wishlist');
+
+ALTER TABLE cart_items ADD COLUMN "list_type" cart_list_type NOT NULL DEFAULT 'cart';
+
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_cart_id_product_id_key;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_cart_id_product_id_list_type_key UNIQUE (cart_id, product_id, list_type);
+
+-- +migrate Down
+DELETE FROM cart_items WHERE list_type <> 'cart';
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_cart_id_product_id_list_type_key;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_cart_id_product_id_key UNIQUE (cart_id, product_id);
+ALTER TABLE cart_items DROP COLUMN IF EXISTS "list_type";
+DROP TYPE IF EXISTS cart_list_type;
@@ -0,0 +1,13 @@
+
+-- +migrate Up notransaction
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'authorized';
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'captured';
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'expired';
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'cancelled';
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'refunded';
+ALTER TYPE "status" ADD VALUE IF NOT EXISTS 'partially_refunded';
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "updated_at";
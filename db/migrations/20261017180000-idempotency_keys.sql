@@ -0,0 +1,18 @@
+
+-- +migrate Up
+CREATE TABLE idempotency_keys (
+    "id" SERIAL PRIMARY KEY,
+    "scope" VARCHAR(50) NOT NULL,
+    "key" VARCHAR(255) NOT NULL,
+    "request_hash" VARCHAR(64) NOT NULL,
+    "response" JSONB NULL,
+    "expires_at" TIMESTAMP NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (scope, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS idempotency_keys;
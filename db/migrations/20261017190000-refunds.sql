@@ -0,0 +1,15 @@
+
+-- +migrate Up
+CREATE TABLE refunds (
+    "id" SERIAL PRIMARY KEY,
+    "payment_id" INTEGER NOT NULL REFERENCES payments(id),
+    "amount" BIGINT NOT NULL CHECK (amount > 0),
+    "currency" CHAR(3) NOT NULL,
+    "reason" VARCHAR(255) NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX refunds_payment_id_idx ON refunds (payment_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS refunds;
@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE payment_methods ADD COLUMN "provider" VARCHAR(50) NOT NULL DEFAULT 'simulator';
+
+ALTER TABLE refunds ADD COLUMN "reference" VARCHAR(255) NULL;
+
+CREATE INDEX IF NOT EXISTS payments_transaction_id_idx ON payments (transaction_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS payments_transaction_id_idx;
+ALTER TABLE refunds DROP COLUMN IF EXISTS "reference";
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS "provider";
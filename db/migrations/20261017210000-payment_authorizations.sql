@@ -0,0 +1,15 @@
+
+-- +migrate Up
+ALTER TABLE payments ADD COLUMN "authorized_amount" BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE payments ADD COLUMN "captured_amount" BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE payments ADD COLUMN "authorization_expires_at" TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS payments_authorization_expires_at_idx
+    ON payments (authorization_expires_at)
+    WHERE status = 'authorized';
+
+-- +migrate Down
+DROP INDEX IF EXISTS payments_authorization_expires_at_idx;
+ALTER TABLE payments DROP COLUMN IF EXISTS "authorization_expires_at";
+ALTER TABLE payments DROP COLUMN IF EXISTS "captured_amount";
+ALTER TABLE payments DROP COLUMN IF EXISTS "authorized_amount";
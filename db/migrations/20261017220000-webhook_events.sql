@@ -0,0 +1,21 @@
+
+-- +migrate Up
+CREATE TABLE webhook_events (
+    "id" SERIAL PRIMARY KEY,
+    "provider" VARCHAR(50) NOT NULL,
+    "event_id" VARCHAR(255) NULL,
+    "reference" VARCHAR(255) NULL,
+    "status" VARCHAR(20) NOT NULL,
+    "signature" TEXT NOT NULL DEFAULT '',
+    "payload" TEXT NOT NULL,
+    "error" TEXT NULL,
+    "processed_at" TIMESTAMP NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (provider, event_id)
+);
+
+CREATE INDEX webhook_events_reference_idx ON webhook_events (reference);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_events;
@@ -0,0 +1,36 @@
+
+-- +migrate Up
+CREATE TABLE webhook_subscriptions (
+    "id" SERIAL PRIMARY KEY,
+    "event_type" VARCHAR(50) NOT NULL,
+    "url" TEXT NOT NULL,
+    "secret" VARCHAR(64) NOT NULL,
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX webhook_subscriptions_event_type_idx ON webhook_subscriptions (event_type) WHERE active;
+
+CREATE TABLE webhook_deliveries (
+    "id" SERIAL PRIMARY KEY,
+    "subscription_id" INT NOT NULL REFERENCES webhook_subscriptions (id),
+    "event_id" VARCHAR(64) NOT NULL,
+    "event_type" VARCHAR(50) NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "last_response_code" INT NULL,
+    "last_error" TEXT NULL,
+    "delivered_at" TIMESTAMP NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_id_idx ON webhook_deliveries (subscription_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
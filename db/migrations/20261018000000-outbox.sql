@@ -0,0 +1,20 @@
+
+-- +migrate Up
+CREATE TABLE outbox (
+    "id" BIGSERIAL PRIMARY KEY,
+    "topic" VARCHAR(100) NOT NULL,
+    "key" VARCHAR(255) NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "last_error" TEXT NULL,
+    "sent_at" TIMESTAMP NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX outbox_due_idx ON outbox (next_attempt_at, id) WHERE status = 'pending';
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox;
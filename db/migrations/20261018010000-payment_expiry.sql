@@ -0,0 +1,14 @@
+
+-- +migrate Up
+ALTER TABLE payment_methods ADD COLUMN "expiry_minutes" INTEGER NULL;
+
+ALTER TABLE payments ADD COLUMN "expires_at" TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS payments_expires_at_idx
+    ON payments (expires_at)
+    WHERE status = 'pending';
+
+-- +migrate Down
+DROP INDEX IF EXISTS payments_expires_at_idx;
+ALTER TABLE payments DROP COLUMN IF EXISTS "expires_at";
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS "expiry_minutes";
@@ -0,0 +1,14 @@
+
+-- +migrate Up
+CREATE SEQUENCE IF NOT EXISTS virtual_account_seq;
+
+ALTER TABLE payments ADD COLUMN "virtual_account" VARCHAR(32) NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS payments_virtual_account_idx
+    ON payments (virtual_account)
+    WHERE virtual_account IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS payments_virtual_account_idx;
+ALTER TABLE payments DROP COLUMN IF EXISTS "virtual_account";
+DROP SEQUENCE IF EXISTS virtual_account_seq;
@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE payments ADD COLUMN "qr_payload" TEXT NULL;
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "qr_payload";
@@ -0,0 +1,26 @@
+
+-- +migrate Up
+CREATE TYPE "payment_method_type" AS ENUM ('card', 'bank_transfer', 'ewallet', 'qris');
+
+ALTER TABLE payment_methods ADD COLUMN "type" payment_method_type NOT NULL DEFAULT 'card';
+ALTER TABLE payment_methods ADD COLUMN "config" JSONB NOT NULL DEFAULT '{}';
+
+UPDATE payment_methods SET "type" = 'bank_transfer' WHERE provider = 'bank_transfer';
+UPDATE payment_methods SET "type" = 'qris' WHERE provider = 'qris';
+
+UPDATE payment_methods
+SET config = jsonb_build_object('expiry_minutes', expiry_minutes)
+WHERE expiry_minutes IS NOT NULL;
+
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS "expiry_minutes";
+
+-- +migrate Down
+ALTER TABLE payment_methods ADD COLUMN "expiry_minutes" INTEGER NULL;
+
+UPDATE payment_methods
+SET expiry_minutes = (config->>'expiry_minutes')::INTEGER
+WHERE config ? 'expiry_minutes';
+
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS "config";
+ALTER TABLE payment_methods DROP COLUMN IF EXISTS "type";
+DROP TYPE IF EXISTS "payment_method_type";
@@ -0,0 +1,11 @@
+
+-- +migrate Up
+CREATE TABLE user_segments (
+    "user_id" BIGINT NOT NULL,
+    "segment" VARCHAR(100) NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("user_id", "segment")
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_segments;
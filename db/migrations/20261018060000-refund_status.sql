@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TYPE "refund_status" AS ENUM ('pending', 'succeeded', 'failed');
+
+-- Refunds recorded so far were only saved after the provider confirmed them.
+ALTER TABLE refunds ADD COLUMN "status" refund_status NOT NULL DEFAULT 'succeeded';
+ALTER TABLE refunds ALTER COLUMN "status" SET DEFAULT 'pending';
+ALTER TABLE refunds ADD COLUMN "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX refunds_pending_idx ON refunds (updated_at) WHERE status = 'pending';
+
+-- +migrate Down
+DROP INDEX IF EXISTS refunds_pending_idx;
+ALTER TABLE refunds DROP COLUMN IF EXISTS "updated_at";
+ALTER TABLE refunds DROP COLUMN IF EXISTS "status";
+DROP TYPE IF EXISTS "refund_status";
@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE idempotency_keys ADD COLUMN "payment_id" INTEGER NULL REFERENCES payments(id);
+
+-- +migrate Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS "payment_id";
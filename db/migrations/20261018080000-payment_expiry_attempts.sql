@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE payments ADD COLUMN "expiry_attempts" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE payments ADD COLUMN "expiry_next_attempt_at" TIMESTAMP NULL;
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "expiry_next_attempt_at";
+ALTER TABLE payments DROP COLUMN IF EXISTS "expiry_attempts";
@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE payments ADD COLUMN "fee_amount" BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE payments DROP COLUMN IF EXISTS "fee_amount";
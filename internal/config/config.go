@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/viper"
@@ -37,3 +38,262 @@ func JWTSigningKey() string {
 func JWTExp() time.Duration {
 	return viper.GetDuration("jwt.exp")
 }
+
+func CartMergePolicy() string {
+	return viper.GetString("cart.merge_policy")
+}
+
+func CartGuestTTL() time.Duration {
+	return viper.GetDuration("cart.guest_ttl")
+}
+
+func CartGuestSweepInterval() time.Duration {
+	return viper.GetDuration("cart.guest_sweep_interval")
+}
+
+func PricingCurrency() string {
+	return viper.GetString("pricing.currency")
+}
+
+func PricingTaxRateBps() int64 {
+	return viper.GetInt64("pricing.tax_rate_bps")
+}
+
+func PricingShippingFlatFee() int64 {
+	return viper.GetInt64("pricing.shipping_flat_fee")
+}
+
+func PricingFreeShippingThreshold() int64 {
+	return viper.GetInt64("pricing.free_shipping_threshold")
+}
+
+func ReservationTTL() time.Duration {
+	return viper.GetDuration("reservation.ttl")
+}
+
+func ReservationSweepInterval() time.Duration {
+	return viper.GetDuration("reservation.sweep_interval")
+}
+
+func CartAbandonAfter() time.Duration {
+	return viper.GetDuration("cart.abandon_after")
+}
+
+func CartAbandonSweepInterval() time.Duration {
+	return viper.GetDuration("cart.abandon_sweep_interval")
+}
+
+func CartAbandonBatchSize() int {
+	return viper.GetInt("cart.abandon_batch_size")
+}
+
+func NotifierDriver() string {
+	return viper.GetString("notifier.driver")
+}
+
+func NotifierSMTPAddr() string {
+	return fmt.Sprintf("%s:%d", viper.GetString("notifier.smtp.host"), viper.GetInt("notifier.smtp.port"))
+}
+
+func NotifierSMTPFrom() string {
+	return viper.GetString("notifier.smtp.from")
+}
+
+func IdempotencyTTL() time.Duration {
+	return viper.GetDuration("idempotency.ttl")
+}
+
+func IdempotencyInProgressTimeout() time.Duration {
+	return viper.GetDuration("idempotency.in_progress_timeout")
+}
+
+func IdempotencySweepInterval() time.Duration {
+	return viper.GetDuration("idempotency.sweep_interval")
+}
+
+// PaymentDefaultProvider names the provider used by payment methods that do
+// not pick one.
+func PaymentDefaultProvider() string {
+	return viper.GetString("payment.default_provider")
+}
+
+// QRISMerchant* describe the merchant in generated QRIS codes. Name and
+// city are cut to the 25 and 15 characters EMVCo allows.
+func QRISMerchantName() string {
+	return viper.GetString("qris.merchant_name")
+}
+
+func QRISMerchantCity() string {
+	return viper.GetString("qris.merchant_city")
+}
+
+func QRISMerchantPAN() string {
+	return viper.GetString("qris.merchant_pan")
+}
+
+func QRISMerchantID() string {
+	return viper.GetString("qris.merchant_id")
+}
+
+func QRISMerchantCategory() string {
+	return viper.GetString("qris.merchant_category")
+}
+
+func QRISCountry() string {
+	return viper.GetString("qris.country")
+}
+
+// PaymentVirtualAccountLength is the number of digits of generated virtual
+// account numbers, bank code and check digit included.
+func PaymentVirtualAccountLength() int {
+	return viper.GetInt("payment.virtual_account_length")
+}
+
+// PaymentAuthorizationTTL is how long an uncaptured authorization is held
+// before it is voided.
+func PaymentAuthorizationTTL() time.Duration {
+	return viper.GetDuration("payment.authorization_ttl")
+}
+
+func PaymentAuthorizationSweepInterval() time.Duration {
+	return viper.GetDuration("payment.authorization_sweep_interval")
+}
+
+func PaymentAuthorizationBatchSize() int {
+	return viper.GetInt("payment.authorization_batch_size")
+}
+
+// PaymentPendingTTL is how long a pending payment waits to be completed
+// before it expires, for payment methods that do not set their own expiry.
+func PaymentPendingTTL() time.Duration {
+	return viper.GetDuration("payment.pending_ttl")
+}
+
+func PaymentPendingSweepInterval() time.Duration {
+	return viper.GetDuration("payment.pending_sweep_interval")
+}
+
+func PaymentPendingBatchSize() int {
+	return viper.GetInt("payment.pending_batch_size")
+}
+
+// PaymentPendingLease is how long a claimed expired payment stays hidden
+// from other expirers.
+func PaymentPendingLease() time.Duration {
+	return viper.GetDuration("payment.pending_lease")
+}
+
+// PaymentPendingBackoff is the delay before an expired payment that could
+// not be voided is tried again; it doubles with every further attempt up to
+// PaymentPendingMaxBackoff.
+func PaymentPendingBackoff() time.Duration {
+	return viper.GetDuration("payment.pending_backoff")
+}
+
+func PaymentPendingMaxBackoff() time.Duration {
+	return viper.GetDuration("payment.pending_max_backoff")
+}
+
+// RefundRetryAfter is how long a refund stays pending before the provider is
+// asked for it again.
+func RefundRetryAfter() time.Duration {
+	return viper.GetDuration("refund.retry_after")
+}
+
+func RefundRetryInterval() time.Duration {
+	return viper.GetDuration("refund.retry_interval")
+}
+
+func RefundRetryBatchSize() int {
+	return viper.GetInt("refund.retry_batch_size")
+}
+
+// WebhookSecret returns the key used to sign webhooks from the provider, or
+// an empty string when the provider has no webhooks configured.
+func WebhookSecret(provider string) string {
+	return viper.GetString(fmt.Sprintf("webhook.secrets.%s", provider))
+}
+
+// MerchantWebhookMaxAttempts is how often a delivery is tried before it is
+// given up.
+func MerchantWebhookMaxAttempts() int {
+	return viper.GetInt("merchant_webhook.max_attempts")
+}
+
+// MerchantWebhookBackoff is the delay before the first retry; it doubles
+// with every further attempt up to MerchantWebhookMaxBackoff.
+func MerchantWebhookBackoff() time.Duration {
+	return viper.GetDuration("merchant_webhook.backoff")
+}
+
+func MerchantWebhookMaxBackoff() time.Duration {
+	return viper.GetDuration("merchant_webhook.max_backoff")
+}
+
+func MerchantWebhookTimeout() time.Duration {
+	return viper.GetDuration("merchant_webhook.timeout")
+}
+
+func MerchantWebhookSweepInterval() time.Duration {
+	return viper.GetDuration("merchant_webhook.sweep_interval")
+}
+
+func MerchantWebhookBatchSize() int {
+	return viper.GetInt("merchant_webhook.batch_size")
+}
+
+// OutboxMaxAttempts is how often the relay tries a message before it is
+// parked as failed.
+func OutboxMaxAttempts() int {
+	return viper.GetInt("outbox.max_attempts")
+}
+
+func OutboxBackoff() time.Duration {
+	return viper.GetDuration("outbox.backoff")
+}
+
+func OutboxMaxBackoff() time.Duration {
+	return viper.GetDuration("outbox.max_backoff")
+}
+
+// OutboxLease is how long a claimed message stays hidden from other relays.
+func OutboxLease() time.Duration {
+	return viper.GetDuration("outbox.lease")
+}
+
+func OutboxSweepInterval() time.Duration {
+	return viper.GetDuration("outbox.sweep_interval")
+}
+
+func OutboxBatchSize() int {
+	return viper.GetInt("outbox.batch_size")
+}
+
+// EventBusDriver selects where domain events go: "memory" keeps them in
+// process, "nats" publishes them to a NATS server.
+func EventBusDriver() string {
+	return viper.GetString("event_bus.driver")
+}
+
+func EventBusNATSURL() string {
+	return viper.GetString("event_bus.nats.url")
+}
+
+// EventBusNATSDurable names the JetStream consumers this service reads other
+// services' events with; it must stay the same across deploys.
+func EventBusNATSDurable() string {
+	return viper.GetString("event_bus.nats.durable")
+}
+
+// EventBusNATSRetryDelay is how long a consumed event whose handler failed
+// waits before it is delivered again.
+func EventBusNATSRetryDelay() time.Duration {
+	return viper.GetDuration("event_bus.nats.retry_delay")
+}
+
+// EventBusSubjectPrefix is put in front of the event type to build the
+// subject of published events, e.g. "payment" and "payment.created" give
+// "payment.payment.created". Subscriptions do not use it.
+func EventBusSubjectPrefix() string {
+	return viper.GetString("event_bus.subject_prefix")
+}
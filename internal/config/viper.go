@@ -10,8 +10,63 @@ func LoadWithViper() {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath(".")
+	setDefaults()
 
 	if err := viper.ReadInConfig(); err != nil {
 		log.Fatalf("Error reading config file, %s", err)
 	}
 }
+
+func setDefaults() {
+	viper.SetDefault("cart.merge_policy", "sum")
+	viper.SetDefault("cart.guest_ttl", "168h")
+	viper.SetDefault("cart.guest_sweep_interval", "1h")
+	viper.SetDefault("cart.abandon_after", "24h")
+	viper.SetDefault("cart.abandon_sweep_interval", "1h")
+	viper.SetDefault("cart.abandon_batch_size", 100)
+	viper.SetDefault("pricing.currency", "IDR")
+	viper.SetDefault("reservation.ttl", "15m")
+	viper.SetDefault("reservation.sweep_interval", "1m")
+	viper.SetDefault("idempotency.ttl", "24h")
+	viper.SetDefault("idempotency.in_progress_timeout", "1m")
+	viper.SetDefault("idempotency.sweep_interval", "1h")
+	viper.SetDefault("notifier.driver", "log")
+	viper.SetDefault("notifier.smtp.host", "localhost")
+	viper.SetDefault("notifier.smtp.port", 1025)
+	viper.SetDefault("notifier.smtp.from", "no-reply@localhost")
+	viper.SetDefault("payment.default_provider", "simulator")
+	viper.SetDefault("payment.virtual_account_length", 16)
+	viper.SetDefault("qris.merchant_name", "Ecommerce Store")
+	viper.SetDefault("qris.merchant_city", "Jakarta")
+	viper.SetDefault("qris.merchant_category", "5999")
+	viper.SetDefault("qris.country", "ID")
+	viper.SetDefault("payment.authorization_ttl", "168h")
+	viper.SetDefault("payment.authorization_sweep_interval", "10m")
+	viper.SetDefault("payment.authorization_batch_size", 100)
+	viper.SetDefault("payment.pending_ttl", "24h")
+	viper.SetDefault("payment.pending_sweep_interval", "1m")
+	viper.SetDefault("payment.pending_batch_size", 100)
+	viper.SetDefault("payment.pending_lease", "5m")
+	viper.SetDefault("payment.pending_backoff", "1m")
+	viper.SetDefault("payment.pending_max_backoff", "1h")
+	viper.SetDefault("refund.retry_after", "1m")
+	viper.SetDefault("refund.retry_interval", "1m")
+	viper.SetDefault("refund.retry_batch_size", 100)
+	viper.SetDefault("merchant_webhook.max_attempts", 8)
+	viper.SetDefault("merchant_webhook.backoff", "30s")
+	viper.SetDefault("merchant_webhook.max_backoff", "6h")
+	viper.SetDefault("merchant_webhook.timeout", "10s")
+	viper.SetDefault("merchant_webhook.sweep_interval", "15s")
+	viper.SetDefault("merchant_webhook.batch_size", 50)
+	viper.SetDefault("outbox.max_attempts", 20)
+	viper.SetDefault("outbox.backoff", "5s")
+	viper.SetDefault("outbox.max_backoff", "10m")
+	viper.SetDefault("outbox.lease", "1m")
+	viper.SetDefault("outbox.sweep_interval", "5s")
+	viper.SetDefault("outbox.batch_size", 100)
+	viper.SetDefault("event_bus.driver", "memory")
+	viper.SetDefault("event_bus.nats.url", "nats://localhost:4222")
+	viper.SetDefault("event_bus.nats.durable", "payment-cart-service")
+	viper.SetDefault("event_bus.nats.retry_delay", "30s")
+	viper.SetDefault("event_bus.subject_prefix", "payment")
+}
@@ -0,0 +1,46 @@
+package console
+
+import (
+	"context"
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/db"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/notifier"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/repository"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/usecase"
+)
+
+func init() {
+	rootCmd.AddCommand(abandonedCartCmd)
+}
+
+var abandonedCartCmd = &cobra.Command{
+	Use:   "abandoned-carts",
+	Short: "Detect abandoned carts once and send reminders",
+	Long:  `This command marks idle user carts as abandoned and notifies their owners, the same as the periodic job in httpsrv.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config.LoadWithViper()
+		postgresDB := db.NewPostgres()
+		sqlDB, err := postgresDB.DB()
+		if err != nil {
+			log.Fatalf("Failed to get SQL DB from Gorm: %v", err)
+		}
+		defer sqlDB.Close()
+
+		cartNotifier, err := notifier.New()
+		if err != nil {
+			log.Fatalf("Failed to create notifier: %v", err)
+		}
+
+		abandonedCartUsecase := usecase.NewAbandonedCartUsecase(repository.NewCartRepo(postgresDB), cartNotifier, newUserClientGRPC())
+
+		n, err := abandonedCartUsecase.DetectAbandonedCarts(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to detect abandoned carts: %v", err)
+		}
+
+		log.Printf("Marked %d carts as abandoned", n)
+	},
+}
@@ -1,6 +1,7 @@
 package console
 
 import (
+	"context"
 	"log"
 	"net"
 	"net/http"
@@ -9,16 +10,21 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/db"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/eventbus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/notifier"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/provider"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/repository"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/usecase"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	eventHandler "github.com/tubagusmf/ecommerce-payment-cart-service/internal/delivery/event"
 	grpcHandler "github.com/tubagusmf/ecommerce-payment-cart-service/internal/delivery/grpc"
 	httpHandler "github.com/tubagusmf/ecommerce-payment-cart-service/internal/delivery/http"
 
 	pbPayment "github.com/tubagusmf/ecommerce-payment-cart-service/pb/payment_service"
 	pbOrder "github.com/tubagusmf/ecommerce-user-product-service/pb/order"
+	pbProduct "github.com/tubagusmf/ecommerce-user-product-service/pb/product"
 	pbUser "github.com/tubagusmf/ecommerce-user-product-service/pb/user"
 )
 
@@ -36,11 +42,66 @@ var serverCmd = &cobra.Command{
 
 		paymentRepo := repository.NewPaymentRepo(postgresDB)
 		paymentMethodRepo := repository.NewPaymentMethodRepo(postgresDB)
+		userSegmentRepo := repository.NewUserSegmentRepo(postgresDB)
+		cartRepo := repository.NewCartRepo(postgresDB)
+		transactor := repository.NewTransactor(postgresDB)
+		couponRepo := repository.NewCouponRepo(postgresDB)
+		reservationRepo := repository.NewReservationRepo(postgresDB)
+		idempotencyRepo := repository.NewIdempotencyRepo(postgresDB)
+		refundRepo := repository.NewRefundRepo(postgresDB)
+		webhookRepo := repository.NewWebhookRepo(postgresDB)
+		webhookSubscriptionRepo := repository.NewWebhookSubscriptionRepo(postgresDB)
+		webhookDeliveryRepo := repository.NewWebhookDeliveryRepo(postgresDB)
+		outboxRepo := repository.NewOutboxRepo(postgresDB)
 		orderClient := newOrderClientGRPC()
 		userClient := newUserClientGRPC()
+		productClient := newProductClientGRPC()
+		cartNotifier, err := notifier.New()
+		if err != nil {
+			log.Fatalf("Failed to create notifier: %v", err)
+		}
+		eventBus, err := eventbus.New()
+		if err != nil {
+			log.Fatalf("Failed to create event bus: %v", err)
+		}
+		defer eventBus.Close()
+		events := usecase.NewOutboxEventPublisher(outboxRepo)
+		providers := provider.NewRegistry(
+			config.PaymentDefaultProvider(),
+			provider.NewSimulator(),
+			provider.NewBankTransfer(config.PaymentVirtualAccountLength()),
+			provider.NewQRIS(provider.QRISMerchant{
+				Name:     config.QRISMerchantName(),
+				City:     config.QRISMerchantCity(),
+				PAN:      config.QRISMerchantPAN(),
+				ID:       config.QRISMerchantID(),
+				Category: config.QRISMerchantCategory(),
+				Country:  config.QRISCountry(),
+			}),
+		)
+
+		outboxRelay := usecase.NewOutboxRelay(outboxRepo, orderClient, eventBus)
+		webhookSubscriptionUsecase := usecase.NewWebhookSubscriptionUsecase(webhookSubscriptionRepo, webhookDeliveryRepo)
+		promotionUsecase := usecase.NewPromotionUsecase(couponRepo, transactor)
+		reservationUsecase := usecase.NewReservationUsecase(reservationRepo, transactor, productClient)
+		paymentUsecase := usecase.NewPaymentUsecase(paymentRepo, userSegmentRepo, transactor, promotionUsecase, reservationUsecase, idempotencyRepo, providers, webhookSubscriptionUsecase, outboxRepo, events, orderClient, userClient)
+		paymentMethodUsecase := usecase.NewPaymentMethodUsecase(paymentMethodRepo, userSegmentRepo, providers)
+		refundUsecase := usecase.NewRefundUsecase(refundRepo, paymentRepo, transactor, providers, webhookSubscriptionUsecase, events)
+		orderCancellationUsecase := usecase.NewOrderCancellationUsecase(paymentUsecase, refundUsecase)
+		webhookUsecase := usecase.NewWebhookUsecase(webhookRepo, paymentUsecase, providers)
+		pricingEngine := usecase.NewPricingEngine(
+			config.PricingCurrency(),
+			usecase.NewShippingRule(config.PricingShippingFlatFee(), config.PricingFreeShippingThreshold()),
+			usecase.NewCouponRule(promotionUsecase),
+			usecase.NewTaxRule(config.PricingTaxRateBps()),
+		)
+		cartUsecase := usecase.NewCartUsecase(cartRepo, transactor, pricingEngine, promotionUsecase, paymentUsecase, reservationUsecase, productClient, orderClient, userClient, events)
 
-		paymentUsecase := usecase.NewPaymentUsecase(paymentRepo, orderClient, userClient)
-		paymentMethodUsecase := usecase.NewPaymentMethodUsecase(paymentMethodRepo)
+		abandonedCartUsecase := usecase.NewAbandonedCartUsecase(cartRepo, cartNotifier, userClient)
+
+		if err := eventHandler.NewOrderEventConsumer(eventBus, orderCancellationUsecase); err != nil {
+			log.Fatalf("Failed to subscribe to order events: %v", err)
+		}
 
 		quitChannel := make(chan bool, 1)
 
@@ -54,20 +115,124 @@ var serverCmd = &cobra.Command{
 			log.Fatal("Failed to assert paymentMethodUsecase to *usecase.PaymentMethodUsecase")
 		}
 
-		go startHTTPServer(paymentUsecaseConcrete, paymentMethodUsecaseConcrete)
-		go startGRPCServer(paymentUsecaseConcrete)
+		cartUsecaseConcrete, ok := cartUsecase.(*usecase.CartUsecase)
+		if !ok {
+			log.Fatal("Failed to assert cartUsecase to *usecase.CartUsecase")
+		}
+
+		promotionUsecaseConcrete, ok := promotionUsecase.(*usecase.PromotionUsecase)
+		if !ok {
+			log.Fatal("Failed to assert promotionUsecase to *usecase.PromotionUsecase")
+		}
+
+		refundUsecaseConcrete, ok := refundUsecase.(*usecase.RefundUsecase)
+		if !ok {
+			log.Fatal("Failed to assert refundUsecase to *usecase.RefundUsecase")
+		}
+
+		webhookUsecaseConcrete, ok := webhookUsecase.(*usecase.WebhookUsecase)
+		if !ok {
+			log.Fatal("Failed to assert webhookUsecase to *usecase.WebhookUsecase")
+		}
+
+		orderCancellationUsecaseConcrete, ok := orderCancellationUsecase.(*usecase.OrderCancellationUsecase)
+		if !ok {
+			log.Fatal("Failed to assert orderCancellationUsecase to *usecase.OrderCancellationUsecase")
+		}
+
+		webhookSubscriptionUsecaseConcrete, ok := webhookSubscriptionUsecase.(*usecase.WebhookSubscriptionUsecase)
+		if !ok {
+			log.Fatal("Failed to assert webhookSubscriptionUsecase to *usecase.WebhookSubscriptionUsecase")
+		}
+
+		go startHTTPServer(paymentUsecaseConcrete, paymentMethodUsecaseConcrete, cartUsecaseConcrete, promotionUsecaseConcrete, refundUsecaseConcrete, webhookUsecaseConcrete, webhookSubscriptionUsecaseConcrete)
+		go startGRPCServer(paymentUsecaseConcrete, paymentMethodUsecaseConcrete, cartUsecaseConcrete, refundUsecaseConcrete, orderCancellationUsecaseConcrete)
+		go runPeriodically("guest cart expiry", config.CartGuestSweepInterval(), func(ctx context.Context) error {
+			n, err := cartUsecaseConcrete.ExpireGuestCarts(ctx)
+			if n > 0 {
+				log.Printf("Expired %d guest carts", n)
+			}
+			return err
+		})
+		go runPeriodically("abandoned cart", config.CartAbandonSweepInterval(), func(ctx context.Context) error {
+			n, err := abandonedCartUsecase.DetectAbandonedCarts(ctx)
+			if n > 0 {
+				log.Printf("Marked %d carts as abandoned", n)
+			}
+			return err
+		})
+		go runPeriodically("reservation release", config.ReservationSweepInterval(), func(ctx context.Context) error {
+			n, err := reservationUsecase.ReleaseExpired(ctx)
+			if n > 0 {
+				log.Printf("Released %d expired stock reservations", n)
+			}
+			return err
+		})
+
+		go runPeriodically("authorization expiry", config.PaymentAuthorizationSweepInterval(), func(ctx context.Context) error {
+			n, err := paymentUsecaseConcrete.VoidExpiredAuthorizations(ctx)
+			if n > 0 {
+				log.Printf("Voided %d expired payment authorizations", n)
+			}
+			return err
+		})
+		go runPeriodically("pending payment expiry", config.PaymentPendingSweepInterval(), func(ctx context.Context) error {
+			n, err := paymentUsecaseConcrete.ExpirePendingPayments(ctx)
+			if n > 0 {
+				log.Printf("Expired %d pending payments", n)
+			}
+			return err
+		})
+		go runPeriodically("pending refund retry", config.RefundRetryInterval(), func(ctx context.Context) error {
+			n, err := refundUsecaseConcrete.RetryPendingRefunds(ctx)
+			if n > 0 {
+				log.Printf("Completed %d pending refunds", n)
+			}
+			return err
+		})
+		go runPeriodically("merchant webhook delivery", config.MerchantWebhookSweepInterval(), func(ctx context.Context) error {
+			n, err := webhookSubscriptionUsecaseConcrete.DeliverDue(ctx)
+			if n > 0 {
+				log.Printf("Delivered %d merchant webhooks", n)
+			}
+			return err
+		})
+		go runPeriodically("outbox relay", config.OutboxSweepInterval(), func(ctx context.Context) error {
+			n, err := outboxRelay.Relay(ctx)
+			if n > 0 {
+				log.Printf("Relayed %d outbox messages", n)
+			}
+			return err
+		})
+		go runPeriodically("idempotency key cleanup", config.IdempotencySweepInterval(), func(ctx context.Context) error {
+			n, err := paymentUsecaseConcrete.DeleteExpiredIdempotencyKeys(ctx)
+			if n > 0 {
+				log.Printf("Deleted %d expired idempotency keys", n)
+			}
+			return err
+		})
 
 		<-quitChannel
 	},
 }
 
-func startHTTPServer(paymentUsecase *usecase.PaymentUsecase, paymentMethodUsecase *usecase.PaymentMethodUsecase) {
+func startHTTPServer(paymentUsecase *usecase.PaymentUsecase, paymentMethodUsecase *usecase.PaymentMethodUsecase, cartUsecase *usecase.CartUsecase, promotionUsecase *usecase.PromotionUsecase, refundUsecase *usecase.RefundUsecase, webhookUsecase *usecase.WebhookUsecase, webhookSubscriptionUsecase *usecase.WebhookSubscriptionUsecase) {
 	e := echo.New()
 
 	httpHandler.NewPaymentMethodHandler(e, paymentMethodUsecase)
 
 	httpHandler.NewPaymentHttpHandler(e, paymentUsecase, paymentMethodUsecase)
 
+	httpHandler.NewCartHandler(e, cartUsecase)
+
+	httpHandler.NewCouponHandler(e, promotionUsecase)
+
+	httpHandler.NewRefundHandler(e, refundUsecase)
+
+	httpHandler.NewWebhookHandler(e, webhookUsecase)
+
+	httpHandler.NewWebhookSubscriptionHandler(e, webhookSubscriptionUsecase)
+
 	e.GET("/ping", func(c echo.Context) error {
 		return c.String(http.StatusOK, "pong!")
 	})
@@ -78,10 +243,12 @@ func startHTTPServer(paymentUsecase *usecase.PaymentUsecase, paymentMethodUsecas
 	}
 }
 
-func startGRPCServer(paymentUsecase *usecase.PaymentUsecase) {
+func startGRPCServer(paymentUsecase *usecase.PaymentUsecase, paymentMethodUsecase *usecase.PaymentMethodUsecase, cartUsecase *usecase.CartUsecase, refundUsecase *usecase.RefundUsecase, orderCancellationUsecase *usecase.OrderCancellationUsecase) {
 	grpcServer := grpc.NewServer()
-	paymentgRPCHandler := grpcHandler.NewPaymentgRPCHandler(paymentUsecase)
+	paymentgRPCHandler := grpcHandler.NewPaymentgRPCHandler(paymentUsecase, paymentMethodUsecase, refundUsecase, orderCancellationUsecase)
 	pbPayment.RegisterPaymentServiceServer(grpcServer, paymentgRPCHandler)
+	cartgRPCHandler := grpcHandler.NewCartgRPCHandler(cartUsecase)
+	pbPayment.RegisterCartServiceServer(grpcServer, cartgRPCHandler)
 
 	listener, err := net.Listen("tcp", ":7000")
 	if err != nil {
@@ -110,6 +277,14 @@ func newOrderClientGRPC() pbOrder.OrderServiceClient {
 	return pbOrder.NewOrderServiceClient(conn)
 }
 
+func newProductClientGRPC() pbProduct.ProductServiceClient {
+	conn, err := grpc.Dial("localhost:5001", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to Product Service: %v", err)
+	}
+	return pbProduct.NewProductServiceClient(conn)
+}
+
 func init() {
 	rootCmd.AddCommand(serverCmd)
 }
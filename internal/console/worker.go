@@ -0,0 +1,21 @@
+package console
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runPeriodically calls fn every interval until the process exits. Errors are
+// logged and the next tick is attempted regardless.
+func runPeriodically(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Printf("%s worker running every %s", name, interval)
+	for range ticker.C {
+		if err := fn(context.Background()); err != nil {
+			log.Printf("%s worker error: %v", name, err)
+		}
+	}
+}
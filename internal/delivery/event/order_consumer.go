@@ -0,0 +1,44 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type OrderEventConsumer struct {
+	orderCancellationUsecase model.IOrderCancellationUsecase
+}
+
+// NewOrderEventConsumer subscribes to the order service events this service
+// reacts to.
+func NewOrderEventConsumer(bus model.IEventBus, orderCancellationUsecase model.IOrderCancellationUsecase) error {
+	consumer := &OrderEventConsumer{
+		orderCancellationUsecase: orderCancellationUsecase,
+	}
+
+	return bus.Subscribe(model.EventTypeOrderCancelled, consumer.OrderCancelled)
+}
+
+func (c *OrderEventConsumer) OrderCancelled(ctx context.Context, event *model.Event) error {
+	var cancelled model.OrderCancelledEvent
+	if err := json.Unmarshal(event.Payload, &cancelled); err != nil {
+		return err
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"eventID": event.ID,
+		"orderID": cancelled.OrderID,
+	})
+
+	_, err := c.orderCancellationUsecase.HandleOrderCancelled(ctx, cancelled.OrderID, cancelled.Reason)
+	if errors.Is(err, model.ErrPaymentNotFound) {
+		// Orders can be cancelled before they were ever paid for.
+		log.Info("No payment for cancelled order")
+		return nil
+	}
+	return err
+}
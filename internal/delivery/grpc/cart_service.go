@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	pb "github.com/tubagusmf/ecommerce-payment-cart-service/pb/payment_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CartgRPCHandler struct {
+	pb.UnimplementedCartServiceServer
+	cartUsecase model.ICartUsecase
+}
+
+func NewCartgRPCHandler(cartUsecase model.ICartUsecase) *CartgRPCHandler {
+	return &CartgRPCHandler{
+		cartUsecase: cartUsecase,
+	}
+}
+
+func (h *CartgRPCHandler) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.GetCartResponse, error) {
+	var (
+		cart *model.Cart
+		err  error
+	)
+
+	switch {
+	case req.CartId != 0:
+		cart, err = h.cartUsecase.GetCart(ctx, req.CartId)
+	case req.UserId != 0:
+		cart, err = h.cartUsecase.GetCartByUserID(ctx, req.UserId)
+	case req.GuestToken != "":
+		cart, err = h.cartUsecase.GetCartByGuestToken(ctx, req.GuestToken)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Cart ID, user ID or guest token is required")
+	}
+	if err != nil {
+		log.Println("Error fetching cart:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.GetCartResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) AddItem(ctx context.Context, req *pb.AddItemRequest) (*pb.AddItemResponse, error) {
+	cart, err := h.cartUsecase.AddItem(ctx, model.AddCartItemInput{
+		UserID:     req.UserId,
+		GuestToken: req.GuestToken,
+		ProductID:  req.ProductId,
+		Quantity:   req.Quantity,
+		ListType:   model.CartListType(req.ListType),
+	})
+	if err != nil {
+		log.Println("Error adding cart item:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.AddItemResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.UpdateItemResponse, error) {
+	cart, err := h.cartUsecase.UpdateItem(ctx, req.CartId, req.ItemId, model.UpdateCartItemInput{
+		Quantity: req.Quantity,
+	})
+	if err != nil {
+		log.Println("Error updating cart item:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.UpdateItemResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) RemoveItem(ctx context.Context, req *pb.RemoveItemRequest) (*pb.RemoveItemResponse, error) {
+	cart, err := h.cartUsecase.RemoveItem(ctx, req.CartId, req.ItemId)
+	if err != nil {
+		log.Println("Error removing cart item:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.RemoveItemResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) MoveItem(ctx context.Context, req *pb.MoveItemRequest) (*pb.MoveItemResponse, error) {
+	cart, err := h.cartUsecase.MoveItem(ctx, req.CartId, req.ItemId, model.MoveCartItemInput{
+		ListType: model.CartListType(req.ListType),
+	})
+	if err != nil {
+		log.Println("Error moving cart item:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.MoveItemResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) ClearCart(ctx context.Context, req *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	cart, err := h.cartUsecase.ClearCart(ctx, req.CartId)
+	if err != nil {
+		log.Println("Error clearing cart:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.ClearCartResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) CreateGuestCart(ctx context.Context, req *pb.CreateGuestCartRequest) (*pb.CreateGuestCartResponse, error) {
+	cart, err := h.cartUsecase.CreateGuestCart(ctx)
+	if err != nil {
+		log.Println("Error creating guest cart:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.CreateGuestCartResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) MergeCart(ctx context.Context, req *pb.MergeCartRequest) (*pb.MergeCartResponse, error) {
+	cart, err := h.cartUsecase.MergeGuestCart(ctx, model.MergeCartInput{
+		GuestToken: req.GuestToken,
+		UserID:     req.UserId,
+		Policy:     model.MergePolicy(req.Policy),
+	})
+	if err != nil {
+		log.Println("Error merging guest cart:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.MergeCartResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) ApplyCoupon(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.ApplyCouponResponse, error) {
+	cart, err := h.cartUsecase.ApplyCoupon(ctx, req.CartId, model.ApplyCouponInput{Code: req.Code})
+	if err != nil {
+		log.Println("Error applying coupon:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.ApplyCouponResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) RemoveCoupon(ctx context.Context, req *pb.RemoveCouponRequest) (*pb.RemoveCouponResponse, error) {
+	cart, err := h.cartUsecase.RemoveCoupon(ctx, req.CartId)
+	if err != nil {
+		log.Println("Error removing coupon:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.RemoveCouponResponse{Cart: toProtoCart(cart)}, nil
+}
+
+func (h *CartgRPCHandler) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	log.Println("Checking out CartID:", req.CartId)
+
+	result, err := h.cartUsecase.Checkout(ctx, req.CartId, model.CheckoutInput{
+		PaymentMethodID: req.PaymentMethodId,
+		CardNumber:      req.CardNumber,
+		AuthorizeOnly:   req.AuthorizeOnly,
+	})
+	if err != nil {
+		log.Println("Error checking out cart:", err)
+		return nil, cartStatusError(err)
+	}
+
+	return &pb.CheckoutResponse{
+		CartId:  result.CartID,
+		OrderId: result.OrderID,
+		Payment: toProtoProcessPaymentResponse(result.Payment),
+	}, nil
+}
+
+func toProtoCart(cart *model.Cart) *pb.Cart {
+	return &pb.Cart{
+		CartId:        cart.ID,
+		UserId:        cart.UserID,
+		Status:        string(cart.Status),
+		Items:         toProtoCartItems(cart.Items),
+		GuestToken:    cart.GuestToken,
+		Pricing:       toProtoPriceBreakdown(cart.Pricing),
+		CouponCode:    cart.CouponCode,
+		SavedForLater: toProtoCartItems(cart.SavedForLater),
+		Wishlist:      toProtoCartItems(cart.Wishlist),
+	}
+}
+
+func toProtoCartItems(cartItems []model.CartItem) []*pb.CartItem {
+	items := make([]*pb.CartItem, 0, len(cartItems))
+	for _, item := range cartItems {
+		items = append(items, &pb.CartItem{
+			ItemId:    item.ID,
+			ProductId: item.ProductID,
+			Name:      item.Name,
+			UnitPrice: item.UnitPrice,
+			Quantity:  item.Quantity,
+			ListType:  string(item.ListType),
+		})
+	}
+	return items
+}
+
+func toProtoPriceBreakdown(breakdown *model.PriceBreakdown) *pb.PriceBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+
+	lines := make([]*pb.PriceLine, 0, len(breakdown.Lines))
+	for _, line := range breakdown.Lines {
+		lines = append(lines, &pb.PriceLine{
+			ItemId:    line.ItemID,
+			ProductId: line.ProductID,
+			Name:      line.Name,
+			UnitPrice: line.UnitPrice,
+			Quantity:  line.Quantity,
+			Subtotal:  line.Subtotal,
+		})
+	}
+
+	discounts := make([]*pb.PriceAdjustment, 0, len(breakdown.Discounts))
+	for _, discount := range breakdown.Discounts {
+		discounts = append(discounts, &pb.PriceAdjustment{
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		})
+	}
+
+	return &pb.PriceBreakdown{
+		Currency:      breakdown.Currency,
+		Lines:         lines,
+		Subtotal:      breakdown.Subtotal,
+		Discounts:     discounts,
+		DiscountTotal: breakdown.DiscountTotal,
+		Tax:           breakdown.Tax,
+		Shipping:      breakdown.Shipping,
+		GrandTotal:    breakdown.GrandTotal,
+		AppliedCoupon: breakdown.AppliedCoupon,
+	}
+}
+
+func cartStatusError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrCartNotFound), errors.Is(err, model.ErrCartItemNotFound), errors.Is(err, model.ErrCouponNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrCouponInactive), errors.Is(err, model.ErrCouponMinCartValue),
+		errors.Is(err, model.ErrCouponUsageExceeded), errors.Is(err, model.ErrCouponUserLimitReached):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrCartNotActive), errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrCartEmpty),
+		errors.Is(err, model.ErrGuestCartCheckout):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrInvalidProduct), errors.Is(err, model.ErrPaymentMethodNotFound), errors.Is(err, model.ErrInvalidUser),
+		errors.Is(err, model.ErrInvalidMerge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPaymentDeclined):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrCheckoutFailed):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "Failed to process cart: %v", err)
+}
@@ -2,9 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/go-playground/validator/v10"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 	pb "github.com/tubagusmf/ecommerce-payment-cart-service/pb/payment_service"
 	"google.golang.org/grpc/codes"
@@ -13,12 +17,18 @@ import (
 
 type PaymentgRPCHandler struct {
 	pb.UnimplementedPaymentServiceServer
-	paymentUsecase model.IPaymentUsecase
+	paymentUsecase           model.IPaymentUsecase
+	paymentMethodUsecase     model.IPaymentMethodUsecase
+	refundUsecase            model.IRefundUsecase
+	orderCancellationUsecase model.IOrderCancellationUsecase
 }
 
-func NewPaymentgRPCHandler(paymentUsecase model.IPaymentUsecase) *PaymentgRPCHandler {
+func NewPaymentgRPCHandler(paymentUsecase model.IPaymentUsecase, paymentMethodUsecase model.IPaymentMethodUsecase, refundUsecase model.IRefundUsecase, orderCancellationUsecase model.IOrderCancellationUsecase) *PaymentgRPCHandler {
 	return &PaymentgRPCHandler{
-		paymentUsecase: paymentUsecase,
+		paymentUsecase:           paymentUsecase,
+		paymentMethodUsecase:     paymentMethodUsecase,
+		refundUsecase:            refundUsecase,
+		orderCancellationUsecase: orderCancellationUsecase,
 	}
 }
 
@@ -30,39 +40,31 @@ func (h *PaymentgRPCHandler) ProcessPayment(ctx context.Context, req *pb.Process
 		return nil, status.Errorf(codes.InvalidArgument, "Payment method is required")
 	}
 
+	if req.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be greater than zero")
+	}
+
+	if err := helper.Validator.Var(req.Currency, "required,iso4217"); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid currency: %q", req.Currency)
+	}
+
 	paymentMethod, err := h.paymentUsecase.GetPaymentMethodByID(ctx, req.PaymentMethodId)
 	if err != nil {
 		log.Println("Error finding payment method:", err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment method: %v", err)
 	}
 
-	var paymentStatus model.PaymentStatus
-	switch req.Status {
-	case pb.PaymentStatus_PAYMENT_STATUS_PENDING:
-		paymentStatus = model.StatusPending
-	case pb.PaymentStatus_PAYMENT_STATUS_SUCCESS:
-		paymentStatus = model.StatusSuccess
-	case pb.PaymentStatus_PAYMENT_STATUS_FAILED:
-		paymentStatus = model.StatusFailed
-	default:
-		log.Println("Invalid payment status in request")
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment status")
+	if len(req.IdempotencyKey) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency key must be at most 255 characters")
 	}
 
-	createdPayment, err := h.paymentUsecase.ProcessPayment(ctx, req.OrderId, req.UserId, *paymentMethod, paymentStatus)
+	createdPayment, err := h.paymentUsecase.ProcessPaymentIdempotent(ctx, req.IdempotencyKey, req.OrderId, req.UserId, *paymentMethod, model.NewFlatBreakdown(req.Amount, req.Currency), model.PaymentDetails{CardNumber: req.CardNumber})
 	if err != nil {
 		log.Println("Error processing payment:", err)
-		return nil, status.Errorf(codes.Internal, "Failed to process payment: %v", err)
+		return nil, paymentStatusError(err)
 	}
 
-	return &pb.ProcessPaymentResponse{
-		PaymentId:       strconv.FormatInt(createdPayment.ID, 10),
-		OrderId:         createdPayment.OrderID,
-		UserId:          createdPayment.UserID,
-		PaymentMethodId: createdPayment.PaymentMethod.ID,
-		Status:          model.ModelToProtoPaymentStatus(createdPayment.Status),
-		TransactionId:   createdPayment.TransactionID,
-	}, nil
+	return toProtoProcessPaymentResponse(createdPayment), nil
 }
 
 func (h *PaymentgRPCHandler) GetPaymentStatus(ctx context.Context, req *pb.GetPaymentStatusRequest) (*pb.GetPaymentStatusResponse, error) {
@@ -80,18 +82,252 @@ func (h *PaymentgRPCHandler) GetPaymentStatus(ctx context.Context, req *pb.GetPa
 		return nil, status.Errorf(codes.Internal, "Failed to get payment status: %v", err)
 	}
 
-	protoPaymentMethod := &pb.PaymentMethod{
-		PaymentMethodId: payment.PaymentMethod.ID,
-		Name:            payment.PaymentMethod.Name,
-		BankCode:        payment.PaymentMethod.BankCode,
-	}
-
 	return &pb.GetPaymentStatusResponse{
 		PaymentId:     strconv.FormatInt(payment.ID, 10),
 		OrderId:       payment.OrderID,
 		UserId:        payment.UserID,
-		PaymentMethod: protoPaymentMethod,
+		PaymentMethod: model.ModelToProtoPaymentMethod(payment.PaymentMethod),
 		Status:        model.ModelToProtoPaymentStatus(payment.Status),
 		TransactionId: payment.TransactionID,
+		Amount:        payment.Amount,
+		Currency:      payment.Currency,
+	}, nil
+}
+
+func (h *PaymentgRPCHandler) ConfirmPayment(ctx context.Context, req *pb.ConfirmPaymentRequest) (*pb.ConfirmPaymentResponse, error) {
+	log.Println("Confirming payment for OrderID:", req.OrderId)
+
+	if req.OrderId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Order ID is required")
+	}
+
+	if err := h.paymentUsecase.ConfirmPayment(ctx, req.OrderId); err != nil {
+		log.Println("Error confirming payment:", err)
+		switch {
+		case errors.Is(err, model.ErrPaymentNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrInvalidPaymentTransition), errors.Is(err, model.ErrPaymentStillPending):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, model.ErrProviderUnavailable):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to confirm payment: %v", err)
+	}
+
+	payment, err := h.paymentUsecase.GetPaymentByOrderID(ctx, req.OrderId)
+	if err != nil || payment == nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get payment: %v", err)
+	}
+
+	return &pb.ConfirmPaymentResponse{Payment: toProtoProcessPaymentResponse(payment)}, nil
+}
+
+func (h *PaymentgRPCHandler) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	log.Println("Refunding PaymentID:", req.PaymentId)
+
+	paymentID, err := strconv.ParseInt(req.PaymentId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment ID format")
+	}
+
+	result, err := h.refundUsecase.Refund(ctx, paymentID, model.CreateRefund{
+		Amount: req.Amount,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		log.Println("Error refunding payment:", err)
+		var validationErrs validator.ValidationErrors
+		switch {
+		case errors.Is(err, model.ErrPaymentNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrPaymentNotRefundable), errors.Is(err, model.ErrInvalidPaymentTransition),
+			errors.Is(err, model.ErrRefundExceedsBalance):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, model.ErrProviderUnavailable):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		case errors.As(err, &validationErrs):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to refund payment: %v", err)
+	}
+
+	return &pb.RefundPaymentResponse{
+		Refund:          toProtoRefund(result.Refund),
+		Status:          model.ModelToProtoPaymentStatus(result.Payment.Status),
+		RefundedAmount:  result.RefundedAmount,
+		RemainingAmount: result.RemainingAmount,
 	}, nil
 }
+
+func (h *PaymentgRPCHandler) AuthorizePayment(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
+	log.Println("Authorizing payment for OrderID:", req.OrderId)
+
+	if req.PaymentMethodId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Payment method is required")
+	}
+
+	if req.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be greater than zero")
+	}
+
+	if err := helper.Validator.Var(req.Currency, "required,iso4217"); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid currency: %q", req.Currency)
+	}
+
+	paymentMethod, err := h.paymentUsecase.GetPaymentMethodByID(ctx, req.PaymentMethodId)
+	if err != nil {
+		log.Println("Error finding payment method:", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment method: %v", err)
+	}
+
+	if len(req.IdempotencyKey) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency key must be at most 255 characters")
+	}
+
+	payment, err := h.paymentUsecase.AuthorizePaymentIdempotent(ctx, req.IdempotencyKey, req.OrderId, req.UserId, *paymentMethod, model.NewFlatBreakdown(req.Amount, req.Currency), model.PaymentDetails{CardNumber: req.CardNumber})
+	if err != nil {
+		log.Println("Error authorizing payment:", err)
+		return nil, paymentStatusError(err)
+	}
+
+	return toProtoProcessPaymentResponse(payment), nil
+}
+
+func (h *PaymentgRPCHandler) CapturePayment(ctx context.Context, req *pb.CapturePaymentRequest) (*pb.CapturePaymentResponse, error) {
+	log.Println("Capturing PaymentID:", req.PaymentId)
+
+	paymentID, err := strconv.ParseInt(req.PaymentId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment ID format")
+	}
+
+	payment, err := h.paymentUsecase.CapturePayment(ctx, paymentID, model.CapturePaymentInput{Amount: req.Amount})
+	if err != nil {
+		log.Println("Error capturing payment:", err)
+		return nil, paymentStatusError(err)
+	}
+
+	return &pb.CapturePaymentResponse{Payment: toProtoProcessPaymentResponse(payment)}, nil
+}
+
+func (h *PaymentgRPCHandler) VoidPayment(ctx context.Context, req *pb.VoidPaymentRequest) (*pb.VoidPaymentResponse, error) {
+	log.Println("Voiding PaymentID:", req.PaymentId)
+
+	paymentID, err := strconv.ParseInt(req.PaymentId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment ID format")
+	}
+
+	payment, err := h.paymentUsecase.VoidPayment(ctx, paymentID)
+	if err != nil {
+		log.Println("Error voiding payment:", err)
+		return nil, paymentStatusError(err)
+	}
+
+	return &pb.VoidPaymentResponse{Payment: toProtoProcessPaymentResponse(payment)}, nil
+}
+
+func (h *PaymentgRPCHandler) HandleOrderCancelled(ctx context.Context, req *pb.HandleOrderCancelledRequest) (*pb.HandleOrderCancelledResponse, error) {
+	log.Println("Handling cancellation of OrderID:", req.OrderId)
+
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Order ID is required")
+	}
+
+	result, err := h.orderCancellationUsecase.HandleOrderCancelled(ctx, req.OrderId, req.Reason)
+	if err != nil {
+		log.Println("Error handling order cancellation:", err)
+		return nil, paymentStatusError(err)
+	}
+
+	res := &pb.HandleOrderCancelledResponse{
+		Action:  string(result.Action),
+		Payment: toProtoProcessPaymentResponse(result.Payment),
+	}
+	if result.Refund != nil {
+		res.Refund = toProtoRefund(result.Refund)
+	}
+	return res, nil
+}
+
+func (h *PaymentgRPCHandler) ListAvailablePaymentMethods(ctx context.Context, req *pb.ListAvailablePaymentMethodsRequest) (*pb.ListAvailablePaymentMethodsResponse, error) {
+	paymentMethods, err := h.paymentMethodUsecase.FindAvailable(ctx, model.AvailabilityQuery{
+		Amount:   req.Amount,
+		Currency: req.Currency,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		log.Println("Error listing available payment methods:", err)
+		return nil, paymentStatusError(err)
+	}
+
+	res := &pb.ListAvailablePaymentMethodsResponse{
+		PaymentMethods: make([]*pb.PaymentMethod, 0, len(paymentMethods)),
+	}
+	for _, paymentMethod := range paymentMethods {
+		res.PaymentMethods = append(res.PaymentMethods, model.ModelToProtoPaymentMethod(*paymentMethod))
+	}
+	return res, nil
+}
+
+func paymentStatusError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrPaymentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrPaymentAmountMismatch), errors.Is(err, model.ErrPaymentCurrencyMismatch),
+		errors.Is(err, model.ErrIdempotencyKeyMismatch), errors.Is(err, model.ErrProviderNotFound),
+		errors.Is(err, model.ErrCaptureExceedsAuthorization), errors.Is(err, model.ErrInvalidBankCode),
+		errors.Is(err, model.ErrPaymentAmountOutOfRange), errors.Is(err, model.ErrPaymentMethodUnavailable):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInvalidPaymentTransition), errors.Is(err, model.ErrAuthorizationExpired),
+		errors.Is(err, model.ErrPaymentNotRefundable), errors.Is(err, model.ErrRefundExceedsBalance):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrIdempotencyKeyInProgress):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, model.ErrProviderUnavailable), errors.Is(err, model.ErrPaymentNotRecorded):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "Failed to process payment: %v", err)
+}
+
+func toProtoProcessPaymentResponse(payment *model.Payment) *pb.ProcessPaymentResponse {
+	res := &pb.ProcessPaymentResponse{
+		PaymentId:        strconv.FormatInt(payment.ID, 10),
+		OrderId:          payment.OrderID,
+		UserId:           payment.UserID,
+		PaymentMethodId:  payment.PaymentMethod.ID,
+		Status:           model.ModelToProtoPaymentStatus(payment.Status),
+		TransactionId:    payment.TransactionID,
+		Amount:           payment.Amount,
+		Currency:         payment.Currency,
+		AuthorizedAmount: payment.AuthorizedAmount,
+		CapturedAmount:   payment.CapturedAmount,
+		VirtualAccount:   payment.VirtualAccount,
+		QrPayload:        payment.QRPayload,
+	}
+	if payment.AuthorizationExpiresAt != nil {
+		res.AuthorizationExpiresAt = payment.AuthorizationExpiresAt.Format(time.RFC3339)
+	}
+	if payment.ExpiresAt != nil {
+		res.ExpiresAt = payment.ExpiresAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func toProtoRefund(refund *model.Refund) *pb.Refund {
+	return &pb.Refund{
+		RefundId:  refund.ID,
+		PaymentId: strconv.FormatInt(refund.PaymentID, 10),
+		Amount:    refund.Amount,
+		Currency:  refund.Currency,
+		Reason:    refund.Reason,
+		Reference: refund.Reference,
+		Status:    string(refund.Status),
+	}
+}
@@ -0,0 +1,336 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type CartHandler struct {
+	cartUsecase model.ICartUsecase
+}
+
+func NewCartHandler(e *echo.Echo, cartUsecase model.ICartUsecase) {
+	handler := &CartHandler{
+		cartUsecase: cartUsecase,
+	}
+
+	route := e.Group("/v1/carts")
+	route.GET("/:id", handler.GetCart)
+	route.GET("/:id/price", handler.PriceCart)
+	route.GET("/user/:user_id", handler.GetCartByUserID)
+	route.POST("/guest", handler.CreateGuestCart)
+	route.GET("/guest/:token", handler.GetCartByGuestToken)
+	route.POST("/merge", handler.MergeGuestCart)
+	route.POST("/items", handler.AddItem)
+	route.PUT("/:id/items/:item_id", handler.UpdateItem)
+	route.DELETE("/:id/items/:item_id", handler.RemoveItem)
+	route.POST("/:id/items/:item_id/move", handler.MoveItem)
+	route.DELETE("/:id/items", handler.ClearCart)
+	route.POST("/:id/coupon", handler.ApplyCoupon)
+	route.DELETE("/:id/coupon", handler.RemoveCoupon)
+	route.POST("/:id/checkout", handler.Checkout)
+}
+
+func (h *CartHandler) GetCart(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	cart, err := h.cartUsecase.GetCart(c.Request().Context(), id)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   cart,
+	})
+}
+
+func (h *CartHandler) PriceCart(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	breakdown, err := h.cartUsecase.PriceCart(c.Request().Context(), id)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   breakdown,
+	})
+}
+
+func (h *CartHandler) GetCartByUserID(c echo.Context) error {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID format")
+	}
+
+	cart, err := h.cartUsecase.GetCartByUserID(c.Request().Context(), userID)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   cart,
+	})
+}
+
+func (h *CartHandler) CreateGuestCart(c echo.Context) error {
+	cart, err := h.cartUsecase.CreateGuestCart(c.Request().Context())
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusCreated, Response{
+		Status:  http.StatusCreated,
+		Message: "Guest cart created successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) GetCartByGuestToken(c echo.Context) error {
+	cart, err := h.cartUsecase.GetCartByGuestToken(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   cart,
+	})
+}
+
+func (h *CartHandler) MergeGuestCart(c echo.Context) error {
+	var body model.MergeCartInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	cart, err := h.cartUsecase.MergeGuestCart(c.Request().Context(), body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Guest cart merged successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) AddItem(c echo.Context) error {
+	var body model.AddCartItemInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	cart, err := h.cartUsecase.AddItem(c.Request().Context(), body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Item added to cart successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) UpdateItem(c echo.Context) error {
+	cartID, itemID, err := parseCartItemParams(c)
+	if err != nil {
+		return err
+	}
+
+	var body model.UpdateCartItemInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	cart, err := h.cartUsecase.UpdateItem(c.Request().Context(), cartID, itemID, body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Cart item updated successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) RemoveItem(c echo.Context) error {
+	cartID, itemID, err := parseCartItemParams(c)
+	if err != nil {
+		return err
+	}
+
+	cart, err := h.cartUsecase.RemoveItem(c.Request().Context(), cartID, itemID)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Cart item removed successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) MoveItem(c echo.Context) error {
+	cartID, itemID, err := parseCartItemParams(c)
+	if err != nil {
+		return err
+	}
+
+	var body model.MoveCartItemInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	cart, err := h.cartUsecase.MoveItem(c.Request().Context(), cartID, itemID, body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Cart item moved successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) ClearCart(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	cart, err := h.cartUsecase.ClearCart(c.Request().Context(), id)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Cart cleared successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) ApplyCoupon(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	var body model.ApplyCouponInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	cart, err := h.cartUsecase.ApplyCoupon(c.Request().Context(), id, body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Coupon applied successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) RemoveCoupon(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	cart, err := h.cartUsecase.RemoveCoupon(c.Request().Context(), id)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Coupon removed successfully",
+		Data:    cart,
+	})
+}
+
+func (h *CartHandler) Checkout(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	var body model.CheckoutInput
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	result, err := h.cartUsecase.Checkout(c.Request().Context(), id, body)
+	if err != nil {
+		return cartError(err)
+	}
+
+	return c.JSON(http.StatusCreated, Response{
+		Status:  http.StatusCreated,
+		Message: "Checkout completed successfully",
+		Data:    result,
+	})
+}
+
+func parseCartItemParams(c echo.Context) (int64, int64, error) {
+	cartID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	itemID, err := strconv.ParseInt(c.Param("item_id"), 10, 64)
+	if err != nil {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid item ID format")
+	}
+
+	return cartID, itemID, nil
+}
+
+func cartError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrCartNotFound), errors.Is(err, model.ErrCartItemNotFound), errors.Is(err, model.ErrCouponNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrCouponInactive), errors.Is(err, model.ErrCouponMinCartValue),
+		errors.Is(err, model.ErrCouponUsageExceeded), errors.Is(err, model.ErrCouponUserLimitReached):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, model.ErrCartNotActive), errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrCartEmpty),
+		errors.Is(err, model.ErrGuestCartCheckout):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, model.ErrInvalidProduct), errors.Is(err, model.ErrPaymentMethodNotFound), errors.Is(err, model.ErrInvalidUser),
+		errors.Is(err, model.ErrInvalidMerge):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, model.ErrPaymentDeclined):
+		return echo.NewHTTPError(http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, model.ErrCheckoutFailed):
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
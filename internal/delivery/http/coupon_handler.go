@@ -0,0 +1,123 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type CouponHandler struct {
+	promotionUsecase model.IPromotionUsecase
+}
+
+func NewCouponHandler(e *echo.Echo, promotionUsecase model.IPromotionUsecase) {
+	handler := &CouponHandler{
+		promotionUsecase: promotionUsecase,
+	}
+
+	route := e.Group("/v1/coupons")
+	route.GET("", handler.FindAll)
+	route.GET("/:id", handler.FindByID)
+	route.POST("/create", handler.Create)
+	route.PUT("/update/:id", handler.Update)
+	route.DELETE("/delete/:id", handler.Delete)
+}
+
+func (h *CouponHandler) FindAll(c echo.Context) error {
+	coupons, err := h.promotionUsecase.FindAll(c.Request().Context(), model.Coupon{})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   coupons,
+	})
+}
+
+func (h *CouponHandler) FindByID(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	coupon, err := h.promotionUsecase.FindByID(c.Request().Context(), id)
+	if err != nil {
+		return couponError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   coupon,
+	})
+}
+
+func (h *CouponHandler) Create(c echo.Context) error {
+	var body model.CreateCoupon
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := h.promotionUsecase.Create(c.Request().Context(), body); err != nil {
+		return couponError(err)
+	}
+
+	return c.JSON(http.StatusCreated, Response{
+		Status:  http.StatusCreated,
+		Message: "Coupon created successfully",
+	})
+}
+
+func (h *CouponHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	var body model.UpdateCoupon
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := h.promotionUsecase.Update(c.Request().Context(), id, body); err != nil {
+		return couponError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Coupon updated successfully",
+	})
+}
+
+func (h *CouponHandler) Delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	if err := h.promotionUsecase.Delete(c.Request().Context(), id); err != nil {
+		return couponError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Coupon deleted successfully",
+	})
+}
+
+func couponError(err error) error {
+	if errors.Is(err, model.ErrCouponNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "Coupon not found")
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.Is(err, model.ErrInvalidCoupon) || errors.As(err, &validationErrs) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
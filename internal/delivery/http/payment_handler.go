@@ -1,14 +1,21 @@
 package http
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/skip2/go-qrcode"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 )
 
+// qrCodeSize is the width and height, in pixels, of rendered QR codes.
+const qrCodeSize = 256
+
 type PaymentHttpHandler struct {
 	paymentUsecase       model.IPaymentUsecase
 	paymentMethodUsecase model.IPaymentMethodUsecase
@@ -24,7 +31,12 @@ func NewPaymentHttpHandler(e *echo.Echo, paymentUsecase model.IPaymentUsecase, p
 	routePayment.POST("/create", handler.ProcessPayment)
 	routePayment.GET("/", handler.GetPayments)
 	routePayment.GET("/:id", handler.GetPaymentByID)
+	routePayment.GET("/:id/qr.png", handler.GetPaymentQRCode)
 	routePayment.GET("/order/:id", handler.GetPaymentByOrderID)
+	routePayment.POST("/order/:id/confirm", handler.ConfirmPayment)
+	routePayment.POST("/authorize", handler.AuthorizePayment)
+	routePayment.POST("/:id/capture", handler.CapturePayment)
+	routePayment.POST("/:id/void", handler.VoidPayment)
 }
 
 func (h *PaymentHttpHandler) ProcessPayment(c echo.Context) error {
@@ -35,31 +47,32 @@ func (h *PaymentHttpHandler) ProcessPayment(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
 	}
 
+	if err := helper.Validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	paymentMethod, err := h.paymentMethodUsecase.FindByID(c.Request().Context(), req.PaymentMethodID)
 	if err != nil || paymentMethod == nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid payment method"})
 	}
 
-	var paymentStatus model.PaymentStatus
-	switch req.PaymentStatus {
-	case "success":
-		paymentStatus = model.StatusSuccess
-	case "failed":
-		paymentStatus = model.StatusFailed
-	default:
-		paymentStatus = model.StatusPending
+	idempotencyKey := c.Request().Header.Get("Idempotency-Key")
+	if err := helper.Validator.Var(idempotencyKey, "omitempty,max=255"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid Idempotency-Key header"})
 	}
 
-	createdPayment, err := h.paymentUsecase.ProcessPayment(
+	createdPayment, err := h.paymentUsecase.ProcessPaymentIdempotent(
 		c.Request().Context(),
+		idempotencyKey,
 		req.OrderID,
 		req.UserID,
 		*paymentMethod,
-		paymentStatus,
+		model.NewFlatBreakdown(req.Amount, req.Currency),
+		req.PaymentDetails,
 	)
 	if err != nil {
 		log.Println("Error processing payment:", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to process payment"})
+		return paymentErrorResponse(c, err, "Failed to process payment")
 	}
 
 	return c.JSON(http.StatusOK, createdPayment)
@@ -90,6 +103,30 @@ func (h *PaymentHttpHandler) GetPaymentByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, payment)
 }
 
+// GetPaymentQRCode renders the QR code of a QR payment as a PNG image.
+func (h *PaymentHttpHandler) GetPaymentQRCode(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid payment ID"})
+	}
+
+	payment, err := h.paymentUsecase.GetPaymentByID(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Payment not found"})
+	}
+	if payment.QRPayload == "" {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": model.ErrPaymentHasNoQRCode.Error()})
+	}
+
+	png, err := qrcode.Encode(payment.QRPayload, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		log.Println("Error rendering QR code:", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to render QR code"})
+	}
+
+	return c.Blob(http.StatusOK, "image/png", png)
+}
+
 func (h *PaymentHttpHandler) GetPaymentByOrderID(c echo.Context) error {
 	idStr := c.Param("id")
 
@@ -100,3 +137,124 @@ func (h *PaymentHttpHandler) GetPaymentByOrderID(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, payment)
 }
+
+func (h *PaymentHttpHandler) ConfirmPayment(c echo.Context) error {
+	orderID := c.Param("id")
+
+	if err := h.paymentUsecase.ConfirmPayment(c.Request().Context(), orderID); err != nil {
+		log.Println("Error confirming payment:", err)
+		switch {
+		case errors.Is(err, model.ErrPaymentNotFound):
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		case errors.Is(err, model.ErrInvalidPaymentTransition), errors.Is(err, model.ErrPaymentStillPending):
+			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		case errors.Is(err, model.ErrProviderUnavailable):
+			return c.JSON(http.StatusBadGateway, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to confirm payment"})
+	}
+
+	payment, err := h.paymentUsecase.GetPaymentByOrderID(c.Request().Context(), orderID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Payment not found"})
+	}
+
+	return c.JSON(http.StatusOK, payment)
+}
+
+func (h *PaymentHttpHandler) AuthorizePayment(c echo.Context) error {
+	var req model.ProcessPaymentInput
+
+	if err := c.Bind(&req); err != nil {
+		log.Println("Error binding request:", err)
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+
+	if err := helper.Validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	paymentMethod, err := h.paymentMethodUsecase.FindByID(c.Request().Context(), req.PaymentMethodID)
+	if err != nil || paymentMethod == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid payment method"})
+	}
+
+	idempotencyKey := c.Request().Header.Get("Idempotency-Key")
+	if err := helper.Validator.Var(idempotencyKey, "omitempty,max=255"); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid Idempotency-Key header"})
+	}
+
+	payment, err := h.paymentUsecase.AuthorizePaymentIdempotent(
+		c.Request().Context(),
+		idempotencyKey,
+		req.OrderID,
+		req.UserID,
+		*paymentMethod,
+		model.NewFlatBreakdown(req.Amount, req.Currency),
+		req.PaymentDetails,
+	)
+	if err != nil {
+		log.Println("Error authorizing payment:", err)
+		return paymentErrorResponse(c, err, "Failed to authorize payment")
+	}
+
+	return c.JSON(http.StatusOK, payment)
+}
+
+func (h *PaymentHttpHandler) CapturePayment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid payment ID"})
+	}
+
+	var req model.CapturePaymentInput
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+
+	payment, err := h.paymentUsecase.CapturePayment(c.Request().Context(), id, req)
+	if err != nil {
+		log.Println("Error capturing payment:", err)
+		return paymentErrorResponse(c, err, "Failed to capture payment")
+	}
+
+	return c.JSON(http.StatusOK, payment)
+}
+
+func (h *PaymentHttpHandler) VoidPayment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid payment ID"})
+	}
+
+	payment, err := h.paymentUsecase.VoidPayment(c.Request().Context(), id)
+	if err != nil {
+		log.Println("Error voiding payment:", err)
+		return paymentErrorResponse(c, err, "Failed to void payment")
+	}
+
+	return c.JSON(http.StatusOK, payment)
+}
+
+func paymentErrorResponse(c echo.Context, err error, fallback string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, model.ErrPaymentNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, model.ErrPaymentAmountMismatch), errors.Is(err, model.ErrPaymentCurrencyMismatch),
+		errors.Is(err, model.ErrIdempotencyKeyMismatch), errors.Is(err, model.ErrCaptureExceedsAuthorization),
+		errors.Is(err, model.ErrPaymentAmountOutOfRange), errors.Is(err, model.ErrPaymentMethodUnavailable):
+		return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+	case errors.Is(err, model.ErrInvalidPaymentTransition), errors.Is(err, model.ErrIdempotencyKeyInProgress),
+		errors.Is(err, model.ErrAuthorizationExpired):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, model.ErrProviderNotFound), errors.Is(err, model.ErrInvalidBankCode),
+		errors.As(err, &validationErrs):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, model.ErrProviderUnavailable):
+		return c.JSON(http.StatusBadGateway, map[string]string{"error": err.Error()})
+	case errors.Is(err, model.ErrPaymentNotRecorded):
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": fallback})
+}
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 )
@@ -19,6 +21,7 @@ func NewPaymentMethodHandler(e *echo.Echo, paymentMethodUsecase model.IPaymentMe
 
 	route := e.Group("/v1/payment-methods")
 	route.GET("", handler.FindAll)
+	route.GET("/available", handler.FindAvailable)
 	route.GET("/:id", handler.FindByID)
 	route.POST("/create", handler.Create)
 	route.PUT("/update/:id", handler.Update)
@@ -37,6 +40,23 @@ func (h *PaymentMethodHandler) FindAll(c echo.Context) error {
 	})
 }
 
+func (h *PaymentMethodHandler) FindAvailable(c echo.Context) error {
+	var query model.AvailabilityQuery
+	if err := c.Bind(&query); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid query parameters")
+	}
+
+	paymentMethods, err := h.paymentMethodUsecase.FindAvailable(c.Request().Context(), query)
+	if err != nil {
+		return paymentMethodError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   paymentMethods,
+	})
+}
+
 func (h *PaymentMethodHandler) FindByID(c echo.Context) error {
 	idParam := c.Param("id")
 	id, err := strconv.ParseInt(idParam, 10, 64)
@@ -67,7 +87,7 @@ func (h *PaymentMethodHandler) Create(c echo.Context) error {
 
 	err := h.paymentMethodUsecase.Create(c.Request().Context(), body)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return paymentMethodError(err)
 	}
 
 	return c.JSON(http.StatusCreated, Response{
@@ -90,10 +110,7 @@ func (h *PaymentMethodHandler) Update(c echo.Context) error {
 
 	err = h.paymentMethodUsecase.Update(c.Request().Context(), id, body)
 	if err != nil {
-		if err.Error() == "payment method not found" {
-			return echo.NewHTTPError(http.StatusNotFound, "Payment method not found")
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return paymentMethodError(err)
 	}
 
 	return c.JSON(http.StatusOK, Response{
@@ -122,3 +139,17 @@ func (h *PaymentMethodHandler) Delete(c echo.Context) error {
 		Message: "Payment method deleted successfully",
 	})
 }
+
+func paymentMethodError(err error) error {
+	if err.Error() == "payment method not found" {
+		return echo.NewHTTPError(http.StatusNotFound, "Payment method not found")
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.Is(err, model.ErrProviderNotFound) || errors.Is(err, model.ErrInvalidBankCode) ||
+		errors.Is(err, model.ErrInvalidPaymentMethodConfig) || errors.As(err, &validationErrs) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type RefundHandler struct {
+	refundUsecase model.IRefundUsecase
+}
+
+func NewRefundHandler(e *echo.Echo, refundUsecase model.IRefundUsecase) {
+	handler := &RefundHandler{
+		refundUsecase: refundUsecase,
+	}
+
+	route := e.Group("/v1/payments")
+	route.POST("/:id/refunds", handler.Refund)
+	route.GET("/:id/refunds", handler.FindByPaymentID)
+}
+
+func (h *RefundHandler) Refund(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid payment ID")
+	}
+
+	var body model.CreateRefund
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	result, err := h.refundUsecase.Refund(c.Request().Context(), id, body)
+	if err != nil {
+		return refundError(err)
+	}
+
+	return c.JSON(http.StatusCreated, Response{
+		Status:  http.StatusCreated,
+		Message: "Refund created successfully",
+		Data:    result,
+	})
+}
+
+func (h *RefundHandler) FindByPaymentID(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid payment ID")
+	}
+
+	refunds, err := h.refundUsecase.FindByPaymentID(c.Request().Context(), id)
+	if err != nil {
+		return refundError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   refunds,
+	})
+}
+
+func refundError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrPaymentNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrPaymentNotRefundable), errors.Is(err, model.ErrInvalidPaymentTransition):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, model.ErrRefundExceedsBalance):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, model.ErrProviderUnavailable):
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
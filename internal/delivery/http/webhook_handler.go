@@ -0,0 +1,97 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the request
+// body.
+const WebhookSignatureHeader = "X-Signature"
+
+const maxWebhookBodySize = 1 << 20
+
+type WebhookHandler struct {
+	webhookUsecase model.IWebhookUsecase
+}
+
+func NewWebhookHandler(e *echo.Echo, webhookUsecase model.IWebhookUsecase) {
+	handler := &WebhookHandler{
+		webhookUsecase: webhookUsecase,
+	}
+
+	route := e.Group("/v1/webhooks")
+	route.POST("/:provider", handler.Receive)
+	route.POST("/events/:id/replay", handler.Replay)
+}
+
+func (h *WebhookHandler) Receive(c echo.Context) error {
+	payload, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookBodySize))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	event, err := h.webhookUsecase.Receive(
+		c.Request().Context(),
+		c.Param("provider"),
+		payload,
+		c.Request().Header.Get(WebhookSignatureHeader),
+	)
+	if err != nil {
+		return webhookError(err)
+	}
+
+	message := "Webhook processed"
+	if event.Duplicate {
+		message = "Webhook already processed"
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: message,
+		Data:    event,
+	})
+}
+
+func (h *WebhookHandler) Replay(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid webhook event ID")
+	}
+
+	event, err := h.webhookUsecase.Replay(c.Request().Context(), id)
+	if err != nil {
+		return webhookError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Webhook replayed",
+		Data:    event,
+	})
+}
+
+// webhookError answers failures that the provider should retry with a 5xx or
+// 404; anything it cannot fix by retrying gets a 4xx.
+func webhookError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrWebhookProviderUnknown), errors.Is(err, model.ErrWebhookEventNotFound),
+		errors.Is(err, model.ErrPaymentNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrWebhookSignatureInvalid):
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	case errors.Is(err, model.ErrWebhookPayloadInvalid):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, model.ErrWebhookEventNotReplayable):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, model.ErrProviderUnavailable):
+		return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type WebhookSubscriptionHandler struct {
+	subscriptionUsecase model.IWebhookSubscriptionUsecase
+}
+
+func NewWebhookSubscriptionHandler(e *echo.Echo, subscriptionUsecase model.IWebhookSubscriptionUsecase) {
+	handler := &WebhookSubscriptionHandler{
+		subscriptionUsecase: subscriptionUsecase,
+	}
+
+	routeSubscription := e.Group("/v1/webhook-subscriptions")
+	routeSubscription.POST("", handler.Subscribe)
+	routeSubscription.GET("", handler.FindAll)
+	routeSubscription.DELETE("/:id", handler.Unsubscribe)
+	routeSubscription.GET("/:id/deliveries", handler.FindDeliveries)
+
+	routeDelivery := e.Group("/v1/webhook-deliveries")
+	routeDelivery.POST("/:id/redeliver", handler.Redeliver)
+}
+
+func (h *WebhookSubscriptionHandler) Subscribe(c echo.Context) error {
+	var body model.CreateWebhookSubscription
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	subscription, err := h.subscriptionUsecase.Subscribe(c.Request().Context(), body)
+	if err != nil {
+		return webhookSubscriptionError(err)
+	}
+
+	return c.JSON(http.StatusCreated, Response{
+		Status:  http.StatusCreated,
+		Message: "Webhook subscription created successfully",
+		Data:    subscription,
+	})
+}
+
+func (h *WebhookSubscriptionHandler) FindAll(c echo.Context) error {
+	subscriptions, err := h.subscriptionUsecase.FindAll(c.Request().Context())
+	if err != nil {
+		return webhookSubscriptionError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   subscriptions,
+	})
+}
+
+func (h *WebhookSubscriptionHandler) Unsubscribe(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	if err := h.subscriptionUsecase.Unsubscribe(c.Request().Context(), id); err != nil {
+		return webhookSubscriptionError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status:  http.StatusOK,
+		Message: "Webhook subscription deleted successfully",
+	})
+}
+
+func (h *WebhookSubscriptionHandler) FindDeliveries(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	deliveries, err := h.subscriptionUsecase.FindDeliveries(c.Request().Context(), id)
+	if err != nil {
+		return webhookSubscriptionError(err)
+	}
+
+	return c.JSON(http.StatusOK, Response{
+		Status: http.StatusOK,
+		Data:   deliveries,
+	})
+}
+
+func (h *WebhookSubscriptionHandler) Redeliver(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid ID format")
+	}
+
+	delivery, err := h.subscriptionUsecase.Redeliver(c.Request().Context(), id)
+	if err != nil {
+		return webhookSubscriptionError(err)
+	}
+
+	return c.JSON(http.StatusAccepted, Response{
+		Status:  http.StatusAccepted,
+		Message: "Webhook delivery queued",
+		Data:    delivery,
+	})
+}
+
+func webhookSubscriptionError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrWebhookSubscriptionNotFound), errors.Is(err, model.ErrWebhookDeliveryNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
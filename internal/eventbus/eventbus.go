@@ -0,0 +1,25 @@
+package eventbus
+
+import (
+	"fmt"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const (
+	DriverMemory = "memory"
+	DriverNATS   = "nats"
+)
+
+// New returns the event bus selected by the event_bus.driver config key.
+func New() (model.IEventBus, error) {
+	switch config.EventBusDriver() {
+	case DriverMemory:
+		return NewMemoryBus(), nil
+	case DriverNATS:
+		return NewNATSBus(config.EventBusNATSURL(), config.EventBusSubjectPrefix(), config.EventBusNATSDurable(), config.EventBusNATSRetryDelay())
+	default:
+		return nil, fmt.Errorf("unknown event bus driver %q", config.EventBusDriver())
+	}
+}
@@ -0,0 +1,56 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// MemoryBus hands events to in-process subscribers synchronously. It is the
+// default for local development and a stand-in for a real broker.
+type MemoryBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]model.EventHandler
+}
+
+func NewMemoryBus() model.IEventBus {
+	return &MemoryBus{
+		handlers: make(map[string][]model.EventHandler),
+	}
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, event *model.Event) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		logrus.WithFields(logrus.Fields{
+			"eventID":   event.ID,
+			"eventType": event.Type,
+		}).Debug("No subscribers for event")
+		return nil
+	}
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return fmt.Errorf("handler for %s failed: %w", event.Type, err)
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBus) Subscribe(eventType string, handler model.EventHandler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	return nil
+}
+
+func (b *MemoryBus) Close() error {
+	return nil
+}
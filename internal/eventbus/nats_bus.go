@@ -0,0 +1,130 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// natsFlushTimeout bounds how long Publish waits for the server to
+// acknowledge that it received the message.
+const natsFlushTimeout = 5 * time.Second
+
+// NATSBus publishes events as JSON envelopes on the subject
+// "<prefix>.<event type>". The event ID goes into the Nats-Msg-Id header so
+// a JetStream stream on those subjects drops the duplicates that
+// at-least-once delivery produces.
+//
+// Subscriptions are to other services' events, so they use the event type as
+// the subject without the prefix. They are JetStream durable consumers named
+// after durable and the event type, which means a stream must capture those
+// subjects. A message is acked once its handler succeeded and redelivered
+// after retryDelay when it failed, so a failed handler is retried instead of
+// losing the event.
+type NATSBus struct {
+	conn       *nats.Conn
+	js         nats.JetStreamContext
+	prefix     string
+	durable    string
+	retryDelay time.Duration
+}
+
+func NewNATSBus(url, prefix, durable string, retryDelay time.Duration) (model.IEventBus, error) {
+	conn, err := nats.Connect(url, nats.Name("ecommerce-payment-cart-service"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open JetStream: %w", err)
+	}
+	return &NATSBus{
+		conn:       conn,
+		js:         js,
+		prefix:     prefix,
+		durable:    durable,
+		retryDelay: retryDelay,
+	}, nil
+}
+
+func (b *NATSBus) Publish(ctx context.Context, event *model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(b.subject(event.Type))
+	msg.Header.Set(nats.MsgIdHdr, event.ID)
+	msg.Data = data
+	if err := b.conn.PublishMsg(msg); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", event.Type, err)
+	}
+
+	// Core NATS publishes are buffered; flushing makes sure the server has
+	// the message before the outbox marks it as sent.
+	if err := b.conn.FlushTimeout(natsFlushTimeout); err != nil {
+		return fmt.Errorf("failed to flush %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+func (b *NATSBus) Subscribe(eventType string, handler model.EventHandler) error {
+	_, err := b.js.Subscribe(eventType, func(msg *nats.Msg) {
+		log := logrus.WithField("subject", msg.Subject)
+
+		var event model.Event
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			// Redelivering a message that cannot be decoded never helps.
+			log.Error("Failed to decode event: ", err)
+			if err := msg.Term(); err != nil {
+				log.Error("Failed to terminate event: ", err)
+			}
+			return
+		}
+
+		log = log.WithField("eventID", event.ID)
+		if err := handler(context.Background(), &event); err != nil {
+			log.Error("Failed to handle event, retrying in ", b.retryDelay, ": ", err)
+			if err := msg.NakWithDelay(b.retryDelay); err != nil {
+				log.Error("Failed to nak event: ", err)
+			}
+			return
+		}
+		if err := msg.Ack(); err != nil {
+			log.Error("Failed to ack event: ", err)
+		}
+	},
+		nats.Durable(b.consumerName(eventType)),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.DeliverNew(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", eventType, err)
+	}
+	return nil
+}
+
+// Close drains pending messages and subscriptions before disconnecting.
+func (b *NATSBus) Close() error {
+	return b.conn.Drain()
+}
+
+// consumerName builds the durable consumer name, which may not contain dots.
+func (b *NATSBus) consumerName(eventType string) string {
+	return b.durable + "-" + strings.ReplaceAll(eventType, ".", "_")
+}
+
+func (b *NATSBus) subject(eventType string) string {
+	if b.prefix == "" {
+		return eventType
+	}
+	return b.prefix + "." + eventType
+}
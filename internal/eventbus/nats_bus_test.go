@@ -0,0 +1,167 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// These tests need a running NATS server with JetStream enabled, e.g.
+//
+//	docker run --rm -p 4222:4222 nats -js
+//	NATS_TEST_URL=nats://localhost:4222 go test ./internal/eventbus/
+//
+// and are skipped without one.
+func natsTestURL(t *testing.T) string {
+	t.Helper()
+	url := os.Getenv("NATS_TEST_URL")
+	if url == "" {
+		t.Skip("NATS_TEST_URL is not set")
+	}
+	return url
+}
+
+// rawNATS connects the way another service would, without the bus.
+func rawNATS(t *testing.T, url string) *nats.Conn {
+	t.Helper()
+	conn, err := nats.Connect(url)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(conn.Close)
+	return conn
+}
+
+func TestNATSBusPublishesOnPrefixedSubject(t *testing.T) {
+	url := natsTestURL(t)
+
+	// A fresh prefix keeps runs against a shared server apart.
+	prefix := fmt.Sprintf("test%d", time.Now().UnixNano())
+	bus, err := NewNATSBus(url, prefix, "test", time.Second)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = bus.Close() })
+
+	raw := rawNATS(t, url)
+	msgs := make(chan *nats.Msg, 1)
+	if _, err := raw.ChanSubscribe(prefix+"."+model.EventTypePaymentStatusChanged, msgs); err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+	if err := raw.Flush(); err != nil {
+		t.Fatalf("failed to flush: %v", err)
+	}
+
+	payload, _ := json.Marshal(model.PaymentStatusChangedEvent{
+		PaymentID: 1,
+		OrderID:   "order-1",
+		From:      model.StatusPending,
+		To:        model.StatusSuccess,
+	})
+	sent := &model.Event{
+		ID:         "evt-publish",
+		Type:       model.EventTypePaymentStatusChanged,
+		Version:    1,
+		OccurredAt: time.Now().UTC().Truncate(time.Second),
+		Payload:    payload,
+	}
+	if err := bus.Publish(context.Background(), sent); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	select {
+	case msg := <-msgs:
+		if id := msg.Header.Get(nats.MsgIdHdr); id != sent.ID {
+			t.Errorf("%s = %q, want %q", nats.MsgIdHdr, id, sent.ID)
+		}
+		var event model.Event
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			t.Fatalf("failed to decode envelope: %v", err)
+		}
+		if event.ID != sent.ID || event.Type != sent.Type || event.Version != sent.Version || !event.OccurredAt.Equal(sent.OccurredAt) {
+			t.Errorf("received %+v, want %+v", event, sent)
+		}
+		var got model.PaymentStatusChangedEvent
+		if err := json.Unmarshal(event.Payload, &got); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if got.PaymentID != 1 || got.To != model.StatusSuccess {
+			t.Errorf("payload = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not published on the prefixed subject")
+	}
+}
+
+// TestNATSBusConsumesForeignEvents publishes order.cancelled the way the
+// order service does, on the bare subject from a plain connection, and
+// checks that a failed handler gets the event again.
+func TestNATSBusConsumesForeignEvents(t *testing.T) {
+	url := natsTestURL(t)
+	raw := rawNATS(t, url)
+
+	js, err := raw.JetStream()
+	if err != nil {
+		t.Fatalf("failed to open JetStream: %v", err)
+	}
+	stream := fmt.Sprintf("TEST_ORDERS_%d", time.Now().UnixNano())
+	if _, err := js.AddStream(&nats.StreamConfig{Name: stream, Subjects: []string{model.EventTypeOrderCancelled}}); err != nil {
+		t.Fatalf("failed to create stream: %v", err)
+	}
+	t.Cleanup(func() { _ = js.DeleteStream(stream) })
+
+	bus, err := NewNATSBus(url, "payment", stream, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = bus.Close() })
+
+	var mu sync.Mutex
+	var attempts []string
+	handled := make(chan struct{})
+	err = bus.Subscribe(model.EventTypeOrderCancelled, func(ctx context.Context, event *model.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, event.ID)
+		if len(attempts) == 1 {
+			return errors.New("provider unavailable")
+		}
+		close(handled)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	payload, _ := json.Marshal(model.OrderCancelledEvent{OrderID: "order-1", Reason: "customer"})
+	data, _ := json.Marshal(model.Event{
+		ID:         "evt-order-cancelled",
+		Type:       model.EventTypeOrderCancelled,
+		Version:    1,
+		OccurredAt: time.Now().UTC(),
+		Payload:    payload,
+	})
+	if err := raw.Publish(model.EventTypeOrderCancelled, data); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	select {
+	case <-handled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("failed event was not redelivered")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(attempts) != 2 || attempts[0] != "evt-order-cancelled" || attempts[1] != "evt-order-cancelled" {
+		t.Errorf("attempts = %v, want the event twice", attempts)
+	}
+}
@@ -0,0 +1,17 @@
+package helper
+
+import "time"
+
+// Backoff returns the wait before the retry that follows the given number of
+// attempts: base after the first attempt, doubling with every further one
+// and never more than max.
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	backoff := base
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
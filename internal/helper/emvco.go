@@ -0,0 +1,138 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EMVCo merchant-presented QR tags used by the service.
+const (
+	EMVTagPayloadFormat        = "00"
+	EMVTagInitiationMethod     = "01"
+	EMVTagMerchantAccount      = "26"
+	EMVTagMerchantCategory     = "52"
+	EMVTagCurrency             = "53"
+	EMVTagAmount               = "54"
+	EMVTagCountry              = "58"
+	EMVTagMerchantName         = "59"
+	EMVTagMerchantCity         = "60"
+	EMVTagAdditionalData       = "62"
+	EMVTagCRC                  = "63"
+	EMVTagAdditionalDataRef    = "05"
+	EMVInitiationMethodDynamic = "12"
+)
+
+var ErrInvalidEMVPayload = errors.New("invalid EMVCo QR payload")
+
+// EMVField is one tag-length-value data object. Templates carry their
+// nested objects in Children instead of Value.
+type EMVField struct {
+	Tag      string
+	Value    string
+	Children []EMVField
+}
+
+// EncodeEMV encodes the fields in order and appends the CRC field, giving
+// the string that goes into the QR code.
+func EncodeEMV(fields []EMVField) (string, error) {
+	var b strings.Builder
+	if err := writeEMV(&b, fields); err != nil {
+		return "", err
+	}
+
+	// The checksum covers everything up to and including the CRC tag and
+	// its length.
+	b.WriteString(EMVTagCRC + "04")
+	b.WriteString(fmt.Sprintf("%04X", CRC16CCITT([]byte(b.String()))))
+	return b.String(), nil
+}
+
+func writeEMV(b *strings.Builder, fields []EMVField) error {
+	for _, field := range fields {
+		value := field.Value
+		if len(field.Children) > 0 {
+			var nested strings.Builder
+			if err := writeEMV(&nested, field.Children); err != nil {
+				return err
+			}
+			value = nested.String()
+		}
+		if len(field.Tag) != 2 || len(value) == 0 || len(value) > 99 {
+			return fmt.Errorf("%w: field %s has length %d", ErrInvalidEMVPayload, field.Tag, len(value))
+		}
+		fmt.Fprintf(b, "%s%02d%s", field.Tag, len(value), value)
+	}
+	return nil
+}
+
+// DecodeEMV checks the payload's CRC and returns its top-level fields by
+// tag. Template values are returned undecoded; pass them to DecodeEMVValues.
+func DecodeEMV(payload string) (map[string]string, error) {
+	crcStart := len(payload) - 8
+	if crcStart < 0 || payload[crcStart:crcStart+4] != EMVTagCRC+"04" {
+		return nil, fmt.Errorf("%w: missing CRC", ErrInvalidEMVPayload)
+	}
+	want := fmt.Sprintf("%04X", CRC16CCITT([]byte(payload[:crcStart+4])))
+	if !strings.EqualFold(payload[crcStart+4:], want) {
+		return nil, fmt.Errorf("%w: CRC mismatch", ErrInvalidEMVPayload)
+	}
+	return DecodeEMVValues(payload[:crcStart])
+}
+
+// DecodeEMVValues splits a run of TLV objects into values by tag.
+func DecodeEMVValues(data string) (map[string]string, error) {
+	values := make(map[string]string)
+	for i := 0; i < len(data); {
+		if i+4 > len(data) {
+			return nil, fmt.Errorf("%w: truncated field at %d", ErrInvalidEMVPayload, i)
+		}
+		tag := data[i : i+2]
+		length, err := strconv.Atoi(data[i+2 : i+4])
+		if err != nil || i+4+length > len(data) {
+			return nil, fmt.Errorf("%w: bad length for field %s", ErrInvalidEMVPayload, tag)
+		}
+		values[tag] = data[i+4 : i+4+length]
+		i += 4 + length
+	}
+	return values, nil
+}
+
+// CRC16CCITT computes the CRC-16/CCITT-FALSE checksum (polynomial 0x1021,
+// initial value 0xFFFF) that EMVCo QR codes end with.
+func CRC16CCITT(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// FormatEMVAmount writes minor units as the decimal amount EMVCo expects,
+// e.g. 150050 as "1500.50".
+func FormatEMVAmount(amount int64) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
+
+var currencyNumericCodes = map[string]string{
+	"IDR": "360",
+	"MYR": "458",
+	"SGD": "702",
+	"THB": "764",
+	"USD": "840",
+}
+
+// CurrencyNumericCode returns the ISO 4217 numeric code EMVCo uses for the
+// alphabetic currency code.
+func CurrencyNumericCode(currency string) (string, bool) {
+	code, ok := currencyNumericCodes[currency]
+	return code, ok
+}
@@ -0,0 +1,14 @@
+package helper
+
+import "math"
+
+// ToMinorUnits converts a major-unit amount as reported by the product and
+// order services into integer minor units (e.g. 1500.50 -> 150050).
+func ToMinorUnits(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// FromMinorUnits converts integer minor units back into a major-unit amount.
+func FromMinorUnits(amount int64) float64 {
+	return float64(amount) / 100
+}
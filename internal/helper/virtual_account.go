@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// minVirtualAccountSequenceDigits is the fewest sequence digits a virtual
+// account may have, so a long bank code cannot leave too few numbers.
+const minVirtualAccountSequenceDigits = 6
+
+var ErrInvalidVirtualAccountPrefix = errors.New("virtual account prefix must be numeric and leave room for the sequence")
+
+// VirtualAccountNumber builds a length-digit account number out of the
+// numeric prefix, the zero padded sequence and a Luhn check digit, e.g.
+// prefix "014", sequence 42 and length 12 give "01400000042" plus the check
+// digit.
+func VirtualAccountNumber(prefix string, sequence int64, length int) (string, error) {
+	if !isDigits(prefix) {
+		return "", ErrInvalidVirtualAccountPrefix
+	}
+
+	width := length - len(prefix) - 1
+	if width < minVirtualAccountSequenceDigits {
+		return "", ErrInvalidVirtualAccountPrefix
+	}
+
+	body := prefix + fmt.Sprintf("%0*d", width, sequence)
+	if len(body) != length-1 {
+		return "", fmt.Errorf("virtual account sequence %d does not fit in %d digits", sequence, width)
+	}
+	return body + strconv.Itoa(LuhnCheckDigit(body)), nil
+}
+
+// LuhnCheckDigit returns the digit that makes digits followed by it pass the
+// Luhn check. digits must only contain 0-9.
+func LuhnCheckDigit(digits string) int {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
+
+// ValidLuhn reports whether number, check digit included, passes the Luhn
+// check.
+func ValidLuhn(number string) bool {
+	if len(number) < 2 || !isDigits(number) {
+		return false
+	}
+	last := len(number) - 1
+	return LuhnCheckDigit(number[:last]) == int(number[last]-'0')
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
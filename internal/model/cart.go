@@ -0,0 +1,195 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type CartStatus string
+
+const (
+	CartStatusActive     CartStatus = "active"
+	CartStatusCheckedOut CartStatus = "checked_out"
+	CartStatusMerged     CartStatus = "merged"
+	CartStatusExpired    CartStatus = "expired"
+	CartStatusAbandoned  CartStatus = "abandoned"
+)
+
+// CartListType tells which list of the cart an item belongs to. Only items in
+// the cart list are priced and checked out; the other lists survive checkout.
+type CartListType string
+
+const (
+	CartListCart          CartListType = "cart"
+	CartListSavedForLater CartListType = "saved_for_later"
+	CartListWishlist      CartListType = "wishlist"
+)
+
+// MergePolicy decides which quantity wins when a guest cart and a user cart
+// both contain the same product.
+type MergePolicy string
+
+const (
+	// MergePolicySum adds both quantities together, capped at available stock.
+	MergePolicySum MergePolicy = "sum"
+	// MergePolicyKeepNewest keeps the quantity of the most recently updated line.
+	MergePolicyKeepNewest MergePolicy = "keep_newest"
+	// MergePolicyKeepUser keeps the quantity already in the user's cart.
+	MergePolicyKeepUser MergePolicy = "keep_user"
+)
+
+var (
+	ErrCartNotFound      = errors.New("cart not found")
+	ErrCartItemNotFound  = errors.New("cart item not found")
+	ErrCartNotActive     = errors.New("cart is not active")
+	ErrInvalidProduct    = errors.New("invalid product")
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrCartEmpty         = errors.New("cart is empty")
+	ErrCheckoutFailed    = errors.New("checkout failed")
+	ErrGuestCartCheckout = errors.New("guest cart must be merged into a user cart before checkout")
+	ErrInvalidUser       = errors.New("invalid user")
+	ErrInvalidMerge      = errors.New("invalid merge policy")
+)
+
+type ICartRepository interface {
+	Create(ctx context.Context, cart *Cart) error
+	FindByID(ctx context.Context, id int64) (*Cart, error)
+	FindActiveByUserID(ctx context.Context, userID int64) (*Cart, error)
+	FindActiveByGuestToken(ctx context.Context, token string) (*Cart, error)
+	AssignUser(ctx context.Context, id int64, userID int64) error
+	ExpireGuestCarts(ctx context.Context, idleSince time.Time) (int64, error)
+	Touch(ctx context.Context, id int64) error
+	UpdateCouponCode(ctx context.Context, id int64, code string) error
+	UpdateStatus(ctx context.Context, id int64, status CartStatus) error
+	FindIdleUserCarts(ctx context.Context, idleSince time.Time, limit int) ([]*Cart, error)
+	MarkAbandoned(ctx context.Context, id int64, idleSince time.Time) (bool, error)
+	FindLatestAbandonedByUserID(ctx context.Context, userID int64) (*Cart, error)
+	Reactivate(ctx context.Context, id int64) (bool, error)
+	FindItemByID(ctx context.Context, cartID, itemID int64) (*CartItem, error)
+	FindItemByProductID(ctx context.Context, cartID, productID int64, listType CartListType) (*CartItem, error)
+	CreateItem(ctx context.Context, item *CartItem) error
+	UpdateItemQuantity(ctx context.Context, itemID int64, quantity int64) error
+	UpdateItemList(ctx context.Context, itemID int64, listType CartListType) error
+	DeleteItem(ctx context.Context, cartID, itemID int64) error
+	DeleteItems(ctx context.Context, cartID int64, listType CartListType) error
+	MoveSavedItems(ctx context.Context, fromCartID, toCartID int64) error
+}
+
+type ICartUsecase interface {
+	GetCart(ctx context.Context, id int64) (*Cart, error)
+	GetCartByUserID(ctx context.Context, userID int64) (*Cart, error)
+	CreateGuestCart(ctx context.Context) (*Cart, error)
+	GetCartByGuestToken(ctx context.Context, token string) (*Cart, error)
+	MergeGuestCart(ctx context.Context, in MergeCartInput) (*Cart, error)
+	ExpireGuestCarts(ctx context.Context) (int64, error)
+	AddItem(ctx context.Context, in AddCartItemInput) (*Cart, error)
+	UpdateItem(ctx context.Context, cartID, itemID int64, in UpdateCartItemInput) (*Cart, error)
+	RemoveItem(ctx context.Context, cartID, itemID int64) (*Cart, error)
+	MoveItem(ctx context.Context, cartID, itemID int64, in MoveCartItemInput) (*Cart, error)
+	ClearCart(ctx context.Context, cartID int64) (*Cart, error)
+	PriceCart(ctx context.Context, cartID int64) (*PriceBreakdown, error)
+	ApplyCoupon(ctx context.Context, cartID int64, in ApplyCouponInput) (*Cart, error)
+	RemoveCoupon(ctx context.Context, cartID int64) (*Cart, error)
+	Checkout(ctx context.Context, cartID int64, in CheckoutInput) (*CheckoutResult, error)
+}
+
+// IAbandonedCartUsecase finds user carts that have been left idle and sends
+// the owner a reminder.
+type IAbandonedCartUsecase interface {
+	DetectAbandonedCarts(ctx context.Context) (int64, error)
+}
+
+// Cart holds the lines to be checked out in Items. Saved-for-later and
+// wishlist lines are loaded alongside into their own fields.
+type Cart struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id,omitempty" gorm:"default:null"`
+	GuestToken string     `json:"guest_token,omitempty" gorm:"default:null"`
+	CouponCode string     `json:"coupon_code,omitempty" gorm:"default:null"`
+	Status     CartStatus `json:"status"`
+	Items      []CartItem `json:"items" gorm:"foreignKey:CartID"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+
+	SavedForLater []CartItem      `json:"saved_for_later" gorm:"-"`
+	Wishlist      []CartItem      `json:"wishlist" gorm:"-"`
+	Pricing       *PriceBreakdown `json:"pricing,omitempty" gorm:"-"`
+}
+
+// SplitLists moves items that are not in the cart list out of Items and into
+// their own lists.
+func (c *Cart) SplitLists() {
+	items := c.Items
+	c.Items = make([]CartItem, 0, len(items))
+	c.SavedForLater = []CartItem{}
+	c.Wishlist = []CartItem{}
+
+	for _, item := range items {
+		switch item.ListType {
+		case CartListSavedForLater:
+			c.SavedForLater = append(c.SavedForLater, item)
+		case CartListWishlist:
+			c.Wishlist = append(c.Wishlist, item)
+		default:
+			c.Items = append(c.Items, item)
+		}
+	}
+}
+
+// AllItems returns the items of every list.
+func (c *Cart) AllItems() []CartItem {
+	items := make([]CartItem, 0, len(c.Items)+len(c.SavedForLater)+len(c.Wishlist))
+	items = append(items, c.Items...)
+	items = append(items, c.SavedForLater...)
+	return append(items, c.Wishlist...)
+}
+
+type CartItem struct {
+	ID        int64        `json:"id"`
+	CartID    int64        `json:"cart_id"`
+	ProductID int64        `json:"product_id"`
+	Name      string       `json:"name"`
+	UnitPrice int64        `json:"unit_price"`
+	Quantity  int64        `json:"quantity"`
+	ListType  CartListType `json:"list_type"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+type AddCartItemInput struct {
+	UserID     int64  `json:"user_id" validate:"required_without=GuestToken"`
+	GuestToken string `json:"guest_token" validate:"required_without=UserID"`
+	ProductID  int64  `json:"product_id" validate:"required"`
+	Quantity   int64  `json:"quantity" validate:"required,gt=0"`
+
+	ListType CartListType `json:"list_type" validate:"omitempty,oneof=cart saved_for_later wishlist"`
+}
+
+type UpdateCartItemInput struct {
+	Quantity int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+type MoveCartItemInput struct {
+	ListType CartListType `json:"list_type" validate:"required,oneof=cart saved_for_later wishlist"`
+}
+
+type MergeCartInput struct {
+	GuestToken string      `json:"guest_token" validate:"required"`
+	UserID     int64       `json:"user_id" validate:"required"`
+	Policy     MergePolicy `json:"policy" validate:"omitempty,oneof=sum keep_newest keep_user"`
+}
+
+// AuthorizeOnly places a hold on the payment instead of charging it; the
+// payment is captured later, e.g. once the order ships.
+type CheckoutInput struct {
+	PaymentMethodID int64  `json:"payment_method_id" validate:"required"`
+	CardNumber      string `json:"card_number" validate:"omitempty,numeric,min=12,max=19"`
+	AuthorizeOnly   bool   `json:"authorize_only"`
+}
+
+type CheckoutResult struct {
+	CartID  int64    `json:"cart_id"`
+	OrderID string   `json:"order_id"`
+	Payment *Payment `json:"payment"`
+}
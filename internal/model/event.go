@@ -0,0 +1,103 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Domain event types published on the event bus.
+const (
+	EventTypePaymentCreated       = "payment.created"
+	EventTypePaymentStatusChanged = "payment.status_changed"
+	EventTypeRefundIssued         = "refund.issued"
+	EventTypeCartCheckedOut       = "cart.checked_out"
+	// EventTypeOrderCancelRequested asks the order service to cancel an
+	// order that checkout created but could not pay for.
+	EventTypeOrderCancelRequested = "order.cancel_requested"
+)
+
+// Event types published by other services that this service consumes.
+const (
+	EventTypeOrderCancelled = "order.cancelled"
+)
+
+// EventVersion is bumped whenever the envelope or a payload changes in a way
+// consumers must know about.
+const EventVersion = 1
+
+// IEventPublisher is how usecases announce domain events. Publish is called
+// inside the transaction that made the change, so implementations either
+// take part in it or must not fail the change.
+type IEventPublisher interface {
+	Publish(ctx context.Context, eventType string, payload interface{}) error
+}
+
+// IEventBus delivers envelopes to whoever subscribed to their type. Events
+// this service publishes get a subject prefix on brokers that use one;
+// Subscribe takes the event type exactly as the publishing service sends it,
+// without the prefix. A handler error makes the broker deliver the event
+// again later where it can.
+type IEventBus interface {
+	Publish(ctx context.Context, event *Event) error
+	Subscribe(eventType string, handler EventHandler) error
+	Close() error
+}
+
+type EventHandler func(ctx context.Context, event *Event) error
+
+// Event is the versioned envelope every domain event travels in. Payload
+// holds one of the *Event payload types below, encoded as JSON.
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+type PaymentCreatedEvent struct {
+	PaymentID int64         `json:"payment_id"`
+	OrderID   string        `json:"order_id"`
+	UserID    int64         `json:"user_id"`
+	Amount    int64         `json:"amount"`
+	Currency  string        `json:"currency"`
+	Status    PaymentStatus `json:"status"`
+}
+
+type PaymentStatusChangedEvent struct {
+	PaymentID int64         `json:"payment_id"`
+	OrderID   string        `json:"order_id"`
+	From      PaymentStatus `json:"from"`
+	To        PaymentStatus `json:"to"`
+}
+
+type RefundIssuedEvent struct {
+	RefundID  int64  `json:"refund_id"`
+	PaymentID int64  `json:"payment_id"`
+	OrderID   string `json:"order_id"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+	Reason    string `json:"reason,omitempty"`
+	// FullyRefunded is set once nothing of the payment is left to refund.
+	FullyRefunded bool `json:"fully_refunded"`
+}
+
+type CartCheckedOutEvent struct {
+	CartID    int64  `json:"cart_id"`
+	UserID    int64  `json:"user_id"`
+	OrderID   string `json:"order_id"`
+	PaymentID int64  `json:"payment_id"`
+}
+
+type OrderCancelRequestedEvent struct {
+	OrderID string `json:"order_id"`
+	CartID  int64  `json:"cart_id"`
+	UserID  int64  `json:"user_id"`
+	Reason  string `json:"reason"`
+}
+
+type OrderCancelledEvent struct {
+	OrderID string `json:"order_id"`
+	Reason  string `json:"reason,omitempty"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Idempotency scopes namespace the keys sent to each operation.
+const (
+	IdempotencyScopeProcessPayment   = "payment.process"
+	IdempotencyScopeAuthorizePayment = "payment.authorize"
+)
+
+var (
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+type IIdempotencyRepository interface {
+	// Create stores a new key and reports false when it already exists.
+	Create(ctx context.Context, key *IdempotencyKey) (bool, error)
+	Find(ctx context.Context, scope, key string) (*IdempotencyKey, error)
+	SavePaymentID(ctx context.Context, scope, key string, paymentID int64) error
+	SaveResponse(ctx context.Context, scope, key string, response json.RawMessage) error
+	Delete(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// IdempotencyKey remembers the first request sent with a client supplied key
+// and, once it finished, the response it produced. A key without a response
+// belongs to a request that is still being processed. PaymentID is set as
+// soon as the request created its payment.
+type IdempotencyKey struct {
+	ID          int64           `json:"id"`
+	Scope       string          `json:"scope"`
+	Key         string          `json:"key"`
+	RequestHash string          `json:"request_hash"`
+	PaymentID   *int64          `json:"payment_id,omitempty"`
+	Response    json.RawMessage `json:"response,omitempty" gorm:"type:jsonb;default:null"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// HashRequest returns a stable fingerprint of the request fields.
+func HashRequest(fields ...interface{}) (string, error) {
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import "context"
+
+type NotificationEvent string
+
+const (
+	NotificationCartAbandoned NotificationEvent = "cart.abandoned"
+)
+
+// INotifier delivers notifications to customers. Implementations live in the
+// notifier package and are picked through configuration.
+type INotifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+type Notification struct {
+	Event     NotificationEvent      `json:"event"`
+	Recipient string                 `json:"recipient"`
+	Subject   string                 `json:"subject"`
+	Body      string                 `json:"body"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import "context"
+
+// OrderCancellationAction says what was done to an order's payment when the
+// order was cancelled.
+type OrderCancellationAction string
+
+const (
+	// OrderCancellationCancelled means the payment had not settled and was
+	// voided.
+	OrderCancellationCancelled OrderCancellationAction = "cancelled"
+	// OrderCancellationRefunded means the remaining balance was refunded.
+	OrderCancellationRefunded OrderCancellationAction = "refunded"
+	// OrderCancellationNone means the payment was already in a final state,
+	// e.g. because the cancellation was handled before.
+	OrderCancellationNone OrderCancellationAction = "none"
+)
+
+type IOrderCancellationUsecase interface {
+	HandleOrderCancelled(ctx context.Context, orderID, reason string) (*OrderCancellationResult, error)
+}
+
+type OrderCancellationResult struct {
+	Action  OrderCancellationAction `json:"action"`
+	Payment *Payment                `json:"payment"`
+	Refund  *Refund                 `json:"refund,omitempty"`
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Outbox topics. Every topic needs a handler registered with the relay.
+const (
+	OutboxTopicOrderPaid   = "order.paid"
+	OutboxTopicDomainEvent = "domain.event"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusFailed  OutboxStatus = "failed"
+)
+
+type IOutboxRepository interface {
+	Create(ctx context.Context, message *OutboxMessage) error
+	// ClaimDue hands out pending messages that are due, oldest first, and
+	// hides them from other relays until leaseUntil.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*OutboxMessage, error)
+	SaveAttempt(ctx context.Context, message *OutboxMessage) error
+}
+
+// OutboxHandler delivers one message. Messages are delivered at least once,
+// so handlers must tolerate seeing the same message again.
+type OutboxHandler func(ctx context.Context, message *OutboxMessage) error
+
+type IOutboxRelay interface {
+	Handle(topic string, handler OutboxHandler)
+	Relay(ctx context.Context) (int64, error)
+}
+
+// OutboxMessage is a side effect recorded in the same transaction as the
+// change that caused it and delivered by the relay after commit.
+type OutboxMessage struct {
+	ID            int64           `json:"id"`
+	Topic         string          `json:"topic"`
+	Key           string          `json:"key"`
+	Payload       json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Status        OutboxStatus    `json:"status"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	LastError     string          `json:"last_error,omitempty" gorm:"default:null"`
+	SentAt        *time.Time      `json:"sent_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox"
+}
+
+// NewOutboxMessage builds a pending message with payload encoded as JSON.
+// Key identifies the entity the message is about, e.g. the order ID.
+func NewOutboxMessage(topic, key string, payload interface{}) (*OutboxMessage, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		Topic:         topic,
+		Key:           key,
+		Payload:       data,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+type OrderPaidMessage struct {
+	OrderID   string `json:"order_id"`
+	PaymentID int64  `json:"payment_id"`
+}
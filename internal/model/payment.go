@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	pb "github.com/tubagusmf/ecommerce-payment-cart-service/pb/payment_service"
@@ -14,9 +15,29 @@ import (
 type PaymentStatus string
 
 const (
-	StatusPending PaymentStatus = "pending"
-	StatusSuccess PaymentStatus = "success"
-	StatusFailed  PaymentStatus = "failed"
+	StatusPending           PaymentStatus = "pending"
+	StatusSuccess           PaymentStatus = "success"
+	StatusFailed            PaymentStatus = "failed"
+	StatusAuthorized        PaymentStatus = "authorized"
+	StatusCaptured          PaymentStatus = "captured"
+	StatusExpired           PaymentStatus = "expired"
+	StatusCancelled         PaymentStatus = "cancelled"
+	StatusRefunded          PaymentStatus = "refunded"
+	StatusPartiallyRefunded PaymentStatus = "partially_refunded"
+)
+
+var (
+	ErrPaymentNotFound         = errors.New("payment not found")
+	ErrPaymentMethodNotFound   = errors.New("payment method not found")
+	ErrPaymentAmountMismatch   = errors.New("payment amount does not match order total")
+	ErrPaymentCurrencyMismatch = errors.New("payment currency is not supported")
+	ErrInvalidBankCode         = errors.New("bank code cannot prefix virtual account numbers")
+	ErrTransferAmountMismatch  = errors.New("transferred amount does not match the payment amount")
+	ErrPaymentHasNoQRCode      = errors.New("payment has no QR code")
+	ErrPaymentNotRecorded      = errors.New("payment could not be recorded and was reversed at the provider")
+
+	ErrAuthorizationExpired        = errors.New("payment authorization has expired")
+	ErrCaptureExceedsAuthorization = errors.New("capture amount exceeds the authorized amount")
 )
 
 type IPaymentMethodRepository interface {
@@ -30,6 +51,7 @@ type IPaymentMethodRepository interface {
 type IPaymentMethodUsecase interface {
 	FindAll(ctx context.Context, paymentMethod PaymentMethod) ([]*PaymentMethod, error)
 	FindByID(ctx context.Context, id int64) (*PaymentMethod, error)
+	FindAvailable(ctx context.Context, query AvailabilityQuery) ([]*PaymentMethod, error)
 	Create(ctx context.Context, in CreatePaymentMethod) error
 	Update(ctx context.Context, id int64, in UpdatePaymentMethod) error
 	Delete(ctx context.Context, id int64) error
@@ -40,28 +62,52 @@ type IPaymentRepository interface {
 	FindAll(ctx context.Context, payment Payment) ([]*Payment, error)
 	FindById(ctx context.Context, id int64) (*Payment, error)
 	FindByOrderID(ctx context.Context, orderID string) (*Payment, error)
-	UpdateStatus(ctx context.Context, orderID string, status PaymentStatus) error
+	FindByTransactionID(ctx context.Context, transactionID string) (*Payment, error)
+	FindByVirtualAccount(ctx context.Context, virtualAccount string) (*Payment, error)
+	NextVirtualAccountSequence(ctx context.Context) (int64, error)
+	LockByID(ctx context.Context, id int64) (*Payment, error)
+	UpdateStatus(ctx context.Context, id int64, from, to PaymentStatus) (bool, error)
+	UpdateTransactionID(ctx context.Context, id int64, transactionID string) error
+	UpdateQRPayload(ctx context.Context, id int64, qrPayload string) error
+	UpdateAuthorization(ctx context.Context, id int64, amount int64, expiresAt time.Time) error
+	UpdateCapturedAmount(ctx context.Context, id int64, amount int64) error
+	FindExpiredAuthorizations(ctx context.Context, now time.Time, limit int) ([]*Payment, error)
+	// ClaimExpiredPending hands out pending payments that expired and are due
+	// for another try, oldest first, and hides them from other expirers until
+	// leaseUntil.
+	ClaimExpiredPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*Payment, error)
+	DeferExpiry(ctx context.Context, id int64, nextAttemptAt time.Time) error
 	FindPaymentMethodByID(ctx context.Context, id int64) (*PaymentMethod, error)
 }
 
 type IPaymentUsecase interface {
-	ProcessPayment(ctx context.Context, orderID string, userID int64, paymentMethod PaymentMethod, paymentStatus PaymentStatus) (*Payment, error)
+	ProcessPayment(ctx context.Context, orderID string, userID int64, paymentMethod PaymentMethod, breakdown *PriceBreakdown, details PaymentDetails) (*Payment, error)
+	ProcessPaymentIdempotent(ctx context.Context, idempotencyKey string, orderID string, userID int64, paymentMethod PaymentMethod, breakdown *PriceBreakdown, details PaymentDetails) (*Payment, error)
+	AuthorizePayment(ctx context.Context, orderID string, userID int64, paymentMethod PaymentMethod, breakdown *PriceBreakdown, details PaymentDetails) (*Payment, error)
+	AuthorizePaymentIdempotent(ctx context.Context, idempotencyKey string, orderID string, userID int64, paymentMethod PaymentMethod, breakdown *PriceBreakdown, details PaymentDetails) (*Payment, error)
+	CapturePayment(ctx context.Context, paymentID int64, in CapturePaymentInput) (*Payment, error)
+	VoidPayment(ctx context.Context, paymentID int64) (*Payment, error)
+	CancelPayment(ctx context.Context, paymentID int64) (*Payment, error)
 	ConfirmPayment(ctx context.Context, orderID string) error
+	ApplyProviderUpdate(ctx context.Context, reference string, status PaymentStatus) (*Payment, error)
+	ApplyVirtualAccountTransfer(ctx context.Context, virtualAccount string, amount int64) (*Payment, error)
 	GetPaymentStatus(ctx context.Context, paymentID int64) (*Payment, error)
 	GetPayments(ctx context.Context, payment Payment) ([]*Payment, error)
 	GetPaymentMethodByID(ctx context.Context, methodID int64) (*PaymentMethod, error)
 	GetPaymentByID(ctx context.Context, id int64) (*Payment, error)
 	GetPaymentByOrderID(ctx context.Context, orderID string) (*Payment, error)
-	MarkPaymentPaid(ctx context.Context, id string) error
 }
 
 type PaymentMethod struct {
-	ID        int64      `json:"id"`
-	Name      string     `json:"name"`
-	BankCode  string     `json:"bank_code"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"-"`
+	ID        int64               `json:"id"`
+	Name      string              `json:"name"`
+	BankCode  string              `json:"bank_code"`
+	Type      PaymentMethodType   `json:"type"`
+	Provider  string              `json:"provider"`
+	Config    PaymentMethodConfig `json:"config" gorm:"type:jsonb"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+	DeletedAt *time.Time          `json:"-"`
 }
 
 type Payment struct {
@@ -72,24 +118,85 @@ type Payment struct {
 	PaymentMethod   PaymentMethod `json:"payment_method" gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 	Status          PaymentStatus `json:"status"`
 	TransactionID   string        `json:"transaction_id,omitempty"`
-	CreatedAt       time.Time     `json:"created_at"`
+	Amount          int64         `json:"amount"`
+	Currency        string        `json:"currency"`
+	SubtotalAmount  int64         `json:"subtotal_amount"`
+	DiscountAmount  int64         `json:"discount_amount"`
+	TaxAmount       int64         `json:"tax_amount"`
+	ShippingAmount  int64         `json:"shipping_amount"`
+	FeeAmount       int64         `json:"fee_amount"`
+	CouponCode      string        `json:"coupon_code,omitempty" gorm:"default:null"`
+
+	// Set for two-step payments. The captured amount may be less than the
+	// authorized one, in which case only the captured part was paid.
+	AuthorizedAmount       int64      `json:"authorized_amount,omitempty"`
+	CapturedAmount         int64      `json:"captured_amount,omitempty"`
+	AuthorizationExpiresAt *time.Time `json:"authorization_expires_at,omitempty"`
+
+	// ExpiresAt is when the payment expires if it is still pending.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// ExpiryAttempts counts the tries to void the expired payment at its
+	// provider, and the next try waits for ExpiryNextAttemptAt.
+	ExpiryAttempts      int        `json:"-"`
+	ExpiryNextAttemptAt *time.Time `json:"-"`
+
+	// VirtualAccount is the account number the customer transfers to, for
+	// payments through a virtual account provider.
+	VirtualAccount string `json:"virtual_account,omitempty" gorm:"default:null"`
+	// QRPayload is the EMVCo QR string the customer scans, for payments
+	// through a QR provider.
+	QRPayload string `json:"qr_payload,omitempty" gorm:"default:null"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SettledAmount is the amount actually paid, which is what can be refunded.
+func (p *Payment) SettledAmount() int64 {
+	if p.CapturedAmount > 0 {
+		return p.CapturedAmount
+	}
+	return p.Amount
 }
 
+// Provider names the gateway that processes payments made with the method.
+// Empty selects the configured default on create and keeps the current
+// provider on update. Config is validated against the schema of the type.
 type CreatePaymentMethod struct {
-	Name     string `json:"name" validate:"required"`
-	BankCode string `json:"bank_code" validate:"required"`
+	Name     string              `json:"name" validate:"required"`
+	BankCode string              `json:"bank_code" validate:"required"`
+	Type     PaymentMethodType   `json:"type" validate:"required,oneof=card bank_transfer ewallet qris"`
+	Provider string              `json:"provider"`
+	Config   PaymentMethodConfig `json:"config"`
 }
 
+// UpdatePaymentMethod replaces the method's settings, config included.
 type UpdatePaymentMethod struct {
-	Name     string `json:"name" validate:"required"`
-	BankCode string `json:"bank_code" validate:"required"`
+	Name     string              `json:"name" validate:"required"`
+	BankCode string              `json:"bank_code" validate:"required"`
+	Type     PaymentMethodType   `json:"type" validate:"required,oneof=card bank_transfer ewallet qris"`
+	Provider string              `json:"provider"`
+	Config   PaymentMethodConfig `json:"config"`
 }
 
 type ProcessPaymentInput struct {
 	OrderID         string `json:"order_id" validate:"required"`
 	UserID          int64  `json:"user_id" validate:"required"`
 	PaymentMethodID int64  `json:"payment_method_id" validate:"required"`
-	PaymentStatus   string `json:"payment_status" validate:"required"`
+	Amount          int64  `json:"amount" validate:"required,gt=0"`
+	Currency        string `json:"currency" validate:"required,iso4217"`
+
+	// Deprecated: the status is decided by the payment provider. The field is
+	// still accepted so older clients keep working, but it is ignored.
+	PaymentStatus string `json:"payment_status"`
+
+	PaymentDetails
+}
+
+// CapturePaymentInput captures an authorized payment. A zero amount captures
+// the full authorized amount.
+type CapturePaymentInput struct {
+	Amount int64 `json:"amount" validate:"omitempty,gt=0"`
 }
 
 func ModelToProtoPaymentStatus(status PaymentStatus) pb.PaymentStatus {
@@ -100,6 +207,18 @@ func ModelToProtoPaymentStatus(status PaymentStatus) pb.PaymentStatus {
 		return pb.PaymentStatus_PAYMENT_STATUS_SUCCESS
 	case StatusFailed:
 		return pb.PaymentStatus_PAYMENT_STATUS_FAILED
+	case StatusAuthorized:
+		return pb.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	case StatusCaptured:
+		return pb.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	case StatusExpired:
+		return pb.PaymentStatus_PAYMENT_STATUS_EXPIRED
+	case StatusCancelled:
+		return pb.PaymentStatus_PAYMENT_STATUS_CANCELLED
+	case StatusRefunded:
+		return pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	case StatusPartiallyRefunded:
+		return pb.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED
 	default:
 		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 	}
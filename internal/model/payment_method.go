@@ -0,0 +1,240 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "github.com/tubagusmf/ecommerce-payment-cart-service/pb/payment_service"
+)
+
+type PaymentMethodType string
+
+const (
+	PaymentMethodCard         PaymentMethodType = "card"
+	PaymentMethodBankTransfer PaymentMethodType = "bank_transfer"
+	PaymentMethodEWallet      PaymentMethodType = "ewallet"
+	PaymentMethodQRIS         PaymentMethodType = "qris"
+)
+
+var (
+	ErrInvalidPaymentMethodConfig = errors.New("invalid payment method config")
+	ErrPaymentAmountOutOfRange    = errors.New("payment amount is outside the range the payment method accepts")
+	ErrPaymentMethodUnavailable   = errors.New("payment method is not available for this user at this time")
+)
+
+// PaymentMethodConfig holds the per-method settings. Amounts are in minor
+// units; zero means "not set" for all of them.
+type PaymentMethodConfig struct {
+	// FeeFixed and FeePercentBps are added to every payment made with the
+	// method: a flat fee plus a share of the amount in basis points.
+	FeeFixed      int64 `json:"fee_fixed,omitempty" validate:"gte=0"`
+	FeePercentBps int64 `json:"fee_percent_bps,omitempty" validate:"gte=0,lte=10000"`
+	// VAPrefix replaces the bank code at the start of virtual account
+	// numbers. Bank transfer methods only.
+	VAPrefix string `json:"va_prefix,omitempty" validate:"omitempty,numeric"`
+	// ExpiryMinutes is how long a pending payment may stay unpaid. Unset
+	// falls back to the configured default.
+	ExpiryMinutes int   `json:"expiry_minutes,omitempty" validate:"gte=0"`
+	MinAmount     int64 `json:"min_amount,omitempty" validate:"gte=0"`
+	MaxAmount     int64 `json:"max_amount,omitempty" validate:"gte=0"`
+
+	// The rest decide who may see and use the method. Currencies limits it
+	// to those ISO-4217 codes; unset allows only the pricing currency.
+	// Windows, when set, are the only times it is enabled. UserIDs and
+	// UserSegments, when either is set, limit it to those users and the
+	// users in those segments.
+	Currencies   []string             `json:"currencies,omitempty" validate:"omitempty,dive,iso4217"`
+	Windows      []AvailabilityWindow `json:"windows,omitempty" validate:"omitempty,dive"`
+	UserIDs      []int64              `json:"user_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	UserSegments []string             `json:"user_segments,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+// AvailabilityWindow is a period in which a method is enabled. A missing
+// bound leaves that side open.
+type AvailabilityWindow struct {
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+}
+
+// Contains reports whether now falls within the window.
+func (w AvailabilityWindow) Contains(now time.Time) bool {
+	if w.StartsAt != nil && now.Before(*w.StartsAt) {
+		return false
+	}
+	if w.EndsAt != nil && !now.Before(*w.EndsAt) {
+		return false
+	}
+	return true
+}
+
+// AvailabilityQuery describes a checkout to list payment methods for. A zero
+// UserID stands for an unknown user, who only sees unrestricted methods.
+type AvailabilityQuery struct {
+	Amount   int64  `json:"amount" query:"amount" validate:"required,gt=0"`
+	Currency string `json:"currency" query:"currency" validate:"required,iso4217"`
+	UserID   int64  `json:"user_id" query:"user_id" validate:"gte=0"`
+}
+
+// ValidateFor checks the settings that depend on the method type or on each
+// other. Field ranges are checked through the validate tags.
+func (c PaymentMethodConfig) ValidateFor(methodType PaymentMethodType) error {
+	if c.VAPrefix != "" && methodType != PaymentMethodBankTransfer {
+		return fmt.Errorf("%w: va_prefix is only allowed for bank transfer methods", ErrInvalidPaymentMethodConfig)
+	}
+	if c.MaxAmount > 0 && c.MinAmount > c.MaxAmount {
+		return fmt.Errorf("%w: min_amount exceeds max_amount", ErrInvalidPaymentMethodConfig)
+	}
+	for _, window := range c.Windows {
+		if window.StartsAt != nil && window.EndsAt != nil && !window.EndsAt.After(*window.StartsAt) {
+			return fmt.Errorf("%w: window ends_at must be after starts_at", ErrInvalidPaymentMethodConfig)
+		}
+	}
+	return nil
+}
+
+// AvailableFor reports whether the method may be offered for the checkout
+// at now. defaultCurrency applies when the config lists no currencies, and
+// segments are the ones the query's user belongs to.
+func (c PaymentMethodConfig) AvailableFor(query AvailabilityQuery, segments []string, defaultCurrency string, now time.Time) bool {
+	return c.CheckAvailability(query, segments, defaultCurrency, now) == nil
+}
+
+// CheckAvailability is AvailableFor with the rule that was broken as error:
+// ErrPaymentAmountOutOfRange, ErrPaymentCurrencyMismatch or
+// ErrPaymentMethodUnavailable.
+func (c PaymentMethodConfig) CheckAvailability(query AvailabilityQuery, segments []string, defaultCurrency string, now time.Time) error {
+	if !c.AcceptsAmount(query.Amount) {
+		return ErrPaymentAmountOutOfRange
+	}
+
+	if len(c.Currencies) == 0 {
+		if query.Currency != defaultCurrency {
+			return ErrPaymentCurrencyMismatch
+		}
+	} else if !slices.Contains(c.Currencies, query.Currency) {
+		return ErrPaymentCurrencyMismatch
+	}
+
+	if len(c.Windows) > 0 && !slices.ContainsFunc(c.Windows, func(w AvailabilityWindow) bool { return w.Contains(now) }) {
+		return ErrPaymentMethodUnavailable
+	}
+
+	if len(c.UserIDs) == 0 && len(c.UserSegments) == 0 {
+		return nil
+	}
+	if query.UserID != 0 && slices.Contains(c.UserIDs, query.UserID) {
+		return nil
+	}
+	if slices.ContainsFunc(c.UserSegments, func(segment string) bool { return slices.Contains(segments, segment) }) {
+		return nil
+	}
+	return ErrPaymentMethodUnavailable
+}
+
+// RestrictsUsers reports whether the method is limited to some users, which
+// is when their segments are needed to check it.
+func (c PaymentMethodConfig) RestrictsUsers() bool {
+	return len(c.UserIDs) > 0 || len(c.UserSegments) > 0
+}
+
+// FeeFor is what the method charges on top of amount: the flat fee plus
+// FeePercentBps of the amount, rounded half up.
+func (c PaymentMethodConfig) FeeFor(amount int64) int64 {
+	return c.FeeFixed + (amount*c.FeePercentBps+5000)/10000
+}
+
+// AcceptsAmount reports whether the amount is within the method's limits.
+func (c PaymentMethodConfig) AcceptsAmount(amount int64) bool {
+	if c.MinAmount > 0 && amount < c.MinAmount {
+		return false
+	}
+	if c.MaxAmount > 0 && amount > c.MaxAmount {
+		return false
+	}
+	return true
+}
+
+func (c PaymentMethodConfig) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *PaymentMethodConfig) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*c = PaymentMethodConfig{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into PaymentMethodConfig", value)
+	}
+	return json.Unmarshal(data, c)
+}
+
+func ModelToProtoPaymentMethodType(methodType PaymentMethodType) pb.PaymentMethodType {
+	switch methodType {
+	case PaymentMethodCard:
+		return pb.PaymentMethodType_PAYMENT_METHOD_TYPE_CARD
+	case PaymentMethodBankTransfer:
+		return pb.PaymentMethodType_PAYMENT_METHOD_TYPE_BANK_TRANSFER
+	case PaymentMethodEWallet:
+		return pb.PaymentMethodType_PAYMENT_METHOD_TYPE_EWALLET
+	case PaymentMethodQRIS:
+		return pb.PaymentMethodType_PAYMENT_METHOD_TYPE_QRIS
+	default:
+		return pb.PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED
+	}
+}
+
+func ModelToProtoPaymentMethod(method PaymentMethod) *pb.PaymentMethod {
+	return &pb.PaymentMethod{
+		PaymentMethodId: method.ID,
+		Name:            method.Name,
+		BankCode:        method.BankCode,
+		Type:            ModelToProtoPaymentMethodType(method.Type),
+		Provider:        method.Provider,
+		Config: &pb.PaymentMethodConfig{
+			FeeFixed:      method.Config.FeeFixed,
+			FeePercentBps: method.Config.FeePercentBps,
+			VaPrefix:      method.Config.VAPrefix,
+			ExpiryMinutes: int32(method.Config.ExpiryMinutes),
+			MinAmount:     method.Config.MinAmount,
+			MaxAmount:     method.Config.MaxAmount,
+			Currencies:    method.Config.Currencies,
+			Windows:       modelToProtoWindows(method.Config.Windows),
+			UserIds:       method.Config.UserIDs,
+			UserSegments:  method.Config.UserSegments,
+		},
+	}
+}
+
+func modelToProtoWindows(windows []AvailabilityWindow) []*pb.AvailabilityWindow {
+	res := make([]*pb.AvailabilityWindow, 0, len(windows))
+	for _, window := range windows {
+		protoWindow := &pb.AvailabilityWindow{}
+		if window.StartsAt != nil {
+			protoWindow.StartsAt = window.StartsAt.Format(time.RFC3339)
+		}
+		if window.EndsAt != nil {
+			protoWindow.EndsAt = window.EndsAt.Format(time.RFC3339)
+		}
+		res = append(res, protoWindow)
+	}
+	return res
+}
+
+// VirtualAccountPrefix is what the method's virtual account numbers start
+// with: the configured prefix, or else the bank code.
+func (m PaymentMethod) VirtualAccountPrefix() string {
+	if m.Config.VAPrefix != "" {
+		return m.Config.VAPrefix
+	}
+	return m.BankCode
+}
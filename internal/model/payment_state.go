@@ -0,0 +1,66 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidPaymentTransition = errors.New("invalid payment status transition")
+
+// InvalidTransitionError reports a status change the payment state machine
+// does not allow. It matches ErrInvalidPaymentTransition with errors.Is.
+type InvalidTransitionError struct {
+	From PaymentStatus
+	To   PaymentStatus
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("cannot move payment from %s to %s", e.From, e.To)
+}
+
+func (e *InvalidTransitionError) Is(target error) bool {
+	return target == ErrInvalidPaymentTransition
+}
+
+// paymentTransitions lists, per status, the statuses a payment may move to.
+// Statuses without an entry are final.
+var paymentTransitions = map[PaymentStatus][]PaymentStatus{
+	StatusPending:           {StatusAuthorized, StatusSuccess, StatusFailed, StatusExpired, StatusCancelled},
+	StatusAuthorized:        {StatusCaptured, StatusSuccess, StatusFailed, StatusExpired, StatusCancelled},
+	StatusSuccess:           {StatusRefunded, StatusPartiallyRefunded},
+	StatusCaptured:          {StatusRefunded, StatusPartiallyRefunded},
+	StatusPartiallyRefunded: {StatusPartiallyRefunded, StatusRefunded},
+}
+
+func (s PaymentStatus) CanTransitionTo(to PaymentStatus) bool {
+	for _, next := range paymentTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether the payment can no longer change status.
+func (s PaymentStatus) IsFinal() bool {
+	_, ok := paymentTransitions[s]
+	return !ok
+}
+
+// IsPaid reports whether the payment has settled and money was taken.
+func (s PaymentStatus) IsPaid() bool {
+	switch s {
+	case StatusSuccess, StatusCaptured, StatusPartiallyRefunded, StatusRefunded:
+		return true
+	}
+	return false
+}
+
+// ValidatePaymentTransition returns an *InvalidTransitionError when the
+// payment may not move from one status to the other.
+func ValidatePaymentTransition(from, to PaymentStatus) error {
+	if !from.CanTransitionTo(to) {
+		return &InvalidTransitionError{From: from, To: to}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package model
+
+import "context"
+
+// PricingRule adjusts a cart's price breakdown. Rules are applied in order,
+// so each one sees the adjustments made by the rules before it.
+type PricingRule interface {
+	Name() string
+	Apply(ctx context.Context, cart *Cart, breakdown *PriceBreakdown) error
+}
+
+type IPricingEngine interface {
+	Price(ctx context.Context, cart *Cart) (*PriceBreakdown, error)
+}
+
+// PriceBreakdown holds every amount in integer minor units of Currency.
+type PriceBreakdown struct {
+	Currency      string            `json:"currency"`
+	Lines         []PriceLine       `json:"lines"`
+	Subtotal      int64             `json:"subtotal"`
+	Discounts     []PriceAdjustment `json:"discounts,omitempty"`
+	DiscountTotal int64             `json:"discount_total"`
+	Tax           int64             `json:"tax"`
+	Shipping      int64             `json:"shipping"`
+	// Fee is the payment method fee, set once the method is known.
+	Fee           int64  `json:"fee,omitempty"`
+	GrandTotal    int64  `json:"grand_total"`
+	AppliedCoupon string `json:"applied_coupon,omitempty"`
+}
+
+type PriceLine struct {
+	ItemID    int64  `json:"item_id"`
+	ProductID int64  `json:"product_id"`
+	Name      string `json:"name"`
+	UnitPrice int64  `json:"unit_price"`
+	Quantity  int64  `json:"quantity"`
+	Subtotal  int64  `json:"subtotal"`
+}
+
+type PriceAdjustment struct {
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+	Amount      int64  `json:"amount"`
+}
+
+// NewFlatBreakdown describes a payment for amount with no discounts, tax or
+// shipping on top, as submitted directly by payment API callers.
+func NewFlatBreakdown(amount int64, currency string) *PriceBreakdown {
+	return &PriceBreakdown{
+		Currency:   currency,
+		Subtotal:   amount,
+		GrandTotal: amount,
+	}
+}
+
+// Taxable returns the goods amount after discounts.
+func (b *PriceBreakdown) Taxable() int64 {
+	return b.Subtotal - b.DiscountTotal
+}
+
+// Recalculate derives the totals from the lines and adjustments. Discounts
+// never take the goods amount below zero.
+func (b *PriceBreakdown) Recalculate() {
+	b.Subtotal = 0
+	for _, line := range b.Lines {
+		b.Subtotal += line.Subtotal
+	}
+
+	b.DiscountTotal = 0
+	for _, discount := range b.Discounts {
+		b.DiscountTotal += discount.Amount
+	}
+	if b.DiscountTotal > b.Subtotal {
+		b.DiscountTotal = b.Subtotal
+	}
+
+	b.GrandTotal = b.Taxable() + b.Tax + b.Shipping + b.Fee
+}
+
+// WithFee returns a copy of the breakdown with the payment method fee added
+// to the grand total.
+func (b *PriceBreakdown) WithFee(fee int64) *PriceBreakdown {
+	priced := *b
+	priced.Fee = fee
+	priced.GrandTotal = priced.Taxable() + priced.Tax + priced.Shipping + priced.Fee
+	return &priced
+}
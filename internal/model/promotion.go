@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type CouponType string
+
+const (
+	CouponTypePercentage   CouponType = "percentage"
+	CouponTypeFixedAmount  CouponType = "fixed_amount"
+	CouponTypeFreeShipping CouponType = "free_shipping"
+)
+
+var (
+	ErrCouponNotFound         = errors.New("coupon not found")
+	ErrInvalidCoupon          = errors.New("invalid coupon")
+	ErrCouponInactive         = errors.New("coupon is not active")
+	ErrCouponMinCartValue     = errors.New("cart value is below the coupon minimum")
+	ErrCouponUsageExceeded    = errors.New("coupon usage limit reached")
+	ErrCouponUserLimitReached = errors.New("coupon usage limit reached for user")
+)
+
+type ICouponRepository interface {
+	FindAll(ctx context.Context, coupon Coupon) ([]*Coupon, error)
+	FindByID(ctx context.Context, id int64) (*Coupon, error)
+	FindByCode(ctx context.Context, code string) (*Coupon, error)
+	LockByCode(ctx context.Context, code string) (*Coupon, error)
+	Create(ctx context.Context, coupon *Coupon) error
+	Update(ctx context.Context, coupon Coupon) error
+	Delete(ctx context.Context, id int64) error
+	IncrementUsage(ctx context.Context, id int64) error
+	CountRedemptionsByUser(ctx context.Context, couponID, userID int64) (int64, error)
+	FindRedemptionByPaymentID(ctx context.Context, paymentID int64) (*CouponRedemption, error)
+	CreateRedemption(ctx context.Context, redemption *CouponRedemption) error
+	DeleteRedemption(ctx context.Context, id int64) error
+	DecrementUsage(ctx context.Context, id int64) error
+}
+
+type IPromotionUsecase interface {
+	FindAll(ctx context.Context, coupon Coupon) ([]*Coupon, error)
+	FindByID(ctx context.Context, id int64) (*Coupon, error)
+	Create(ctx context.Context, in CreateCoupon) error
+	Update(ctx context.Context, id int64, in UpdateCoupon) error
+	Delete(ctx context.Context, id int64) error
+	ValidateCoupon(ctx context.Context, code string, userID int64, subtotal int64) (*Coupon, error)
+	Redeem(ctx context.Context, payment *Payment) error
+	Release(ctx context.Context, payment *Payment) error
+}
+
+type Coupon struct {
+	ID           int64      `json:"id"`
+	Code         string     `json:"code"`
+	Type         CouponType `json:"type"`
+	Value        int64      `json:"value"`
+	MaxDiscount  int64      `json:"max_discount"`
+	MinCartValue int64      `json:"min_cart_value"`
+	UsageLimit   int64      `json:"usage_limit"`
+	PerUserLimit int64      `json:"per_user_limit"`
+	UsedCount    int64      `json:"used_count"`
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
+	EndsAt       *time.Time `json:"ends_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"-"`
+}
+
+type CouponRedemption struct {
+	ID             int64     `json:"id"`
+	CouponID       int64     `json:"coupon_id"`
+	UserID         int64     `json:"user_id"`
+	PaymentID      int64     `json:"payment_id"`
+	OrderID        string    `json:"order_id"`
+	DiscountAmount int64     `json:"discount_amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// IsActive reports whether the coupon can be used at now. UsageLimit and
+// PerUserLimit of zero mean unlimited.
+func (c *Coupon) IsActive(now time.Time) bool {
+	if c.DeletedAt != nil {
+		return false
+	}
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return false
+	}
+	if c.EndsAt != nil && !now.Before(*c.EndsAt) {
+		return false
+	}
+	return c.UsageLimit == 0 || c.UsedCount < c.UsageLimit
+}
+
+// Discount returns the goods discount the coupon gives on taxable. Free
+// shipping coupons give no goods discount.
+func (c *Coupon) Discount(taxable int64) int64 {
+	var discount int64
+	switch c.Type {
+	case CouponTypePercentage:
+		discount = taxable * c.Value / 100
+		if c.MaxDiscount > 0 && discount > c.MaxDiscount {
+			discount = c.MaxDiscount
+		}
+	case CouponTypeFixedAmount:
+		discount = c.Value
+	}
+
+	if discount > taxable {
+		discount = taxable
+	}
+	return discount
+}
+
+// CreateCoupon is the admin payload. Value is a whole percentage for
+// percentage coupons and minor units for fixed amount coupons.
+type CreateCoupon struct {
+	Code         string     `json:"code" validate:"required,max=64"`
+	Type         CouponType `json:"type" validate:"required,oneof=percentage fixed_amount free_shipping"`
+	Value        int64      `json:"value" validate:"gte=0"`
+	MaxDiscount  int64      `json:"max_discount" validate:"gte=0"`
+	MinCartValue int64      `json:"min_cart_value" validate:"gte=0"`
+	UsageLimit   int64      `json:"usage_limit" validate:"gte=0"`
+	PerUserLimit int64      `json:"per_user_limit" validate:"gte=0"`
+	StartsAt     *time.Time `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+}
+
+type UpdateCoupon struct {
+	Code         string     `json:"code" validate:"required,max=64"`
+	Type         CouponType `json:"type" validate:"required,oneof=percentage fixed_amount free_shipping"`
+	Value        int64      `json:"value" validate:"gte=0"`
+	MaxDiscount  int64      `json:"max_discount" validate:"gte=0"`
+	MinCartValue int64      `json:"min_cart_value" validate:"gte=0"`
+	UsageLimit   int64      `json:"usage_limit" validate:"gte=0"`
+	PerUserLimit int64      `json:"per_user_limit" validate:"gte=0"`
+	StartsAt     *time.Time `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+}
+
+type ApplyCouponInput struct {
+	Code string `json:"code" validate:"required"`
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrProviderNotFound    = errors.New("payment provider not found")
+	ErrProviderUnavailable = errors.New("payment provider is unavailable")
+	ErrPaymentDeclined     = errors.New("payment was declined")
+	ErrPaymentStillPending = errors.New("payment is still pending at the provider")
+)
+
+// PaymentProvider talks to a payment gateway. Every call returns the
+// gateway's reference for the transaction together with the status the
+// gateway reports. A declined charge is a result with StatusFailed, not an
+// error; errors mean the gateway could not be reached or refused the call.
+type PaymentProvider interface {
+	Name() string
+	// Supports reports whether payment methods of the type may use the
+	// provider.
+	Supports(methodType PaymentMethodType) bool
+	Charge(ctx context.Context, req ProviderRequest) (*ProviderResult, error)
+	Authorize(ctx context.Context, req ProviderRequest) (*ProviderResult, error)
+	Capture(ctx context.Context, reference string, amount int64) (*ProviderResult, error)
+	Void(ctx context.Context, reference string) (*ProviderResult, error)
+	// Refund is keyed by refundID: asking again for the same refund returns
+	// the first result instead of paying the money back twice.
+	Refund(ctx context.Context, reference string, refundID int64, amount int64) (*ProviderResult, error)
+	Status(ctx context.Context, reference string) (*ProviderResult, error)
+}
+
+// VirtualAccountProvider is implemented by providers that collect payments
+// as bank transfers into a virtual account. The service generates a unique
+// account number per payment, prefixed with the payment method's bank code,
+// and passes it in ProviderRequest.VirtualAccount.
+type VirtualAccountProvider interface {
+	// VirtualAccountLength is the number of digits of the account numbers
+	// the provider's banks accept, bank code and check digit included.
+	VirtualAccountLength() int
+}
+
+// IProviderRegistry resolves the provider configured on a payment method.
+type IProviderRegistry interface {
+	// Get returns the named provider, or the default one for an empty name.
+	Get(name string) (PaymentProvider, error)
+	Has(name string) bool
+}
+
+// ProviderRequest carries what a gateway needs to move money for a payment.
+// Amount is in minor units of Currency.
+type ProviderRequest struct {
+	PaymentID  int64
+	OrderID    string
+	Amount     int64
+	Currency   string
+	CardNumber string
+	// VirtualAccount is set for virtual account providers.
+	VirtualAccount string
+}
+
+type ProviderResult struct {
+	Reference string
+	Status    PaymentStatus
+	Message   string
+	// QRPayload is set by QR providers to the code the customer scans.
+	QRPayload string
+}
+
+// PaymentDetails holds the instrument details a caller passes through to the
+// provider. They are never stored.
+type PaymentDetails struct {
+	CardNumber string `json:"card_number" validate:"omitempty,numeric,min=12,max=19"`
+}
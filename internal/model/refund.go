@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrPaymentNotRefundable = errors.New("payment cannot be refunded in its current status")
+	ErrRefundExceedsBalance = errors.New("refund amount exceeds the refundable balance")
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending   RefundStatus = "pending"
+	RefundStatusSucceeded RefundStatus = "succeeded"
+	RefundStatusFailed    RefundStatus = "failed"
+)
+
+type IRefundRepository interface {
+	Create(ctx context.Context, refund *Refund) error
+	FindByPaymentID(ctx context.Context, paymentID int64) ([]*Refund, error)
+	// SumByPaymentID adds up the refunds of the payment that are in one of
+	// the statuses.
+	SumByPaymentID(ctx context.Context, paymentID int64, statuses ...RefundStatus) (int64, error)
+	LockByID(ctx context.Context, id int64) (*Refund, error)
+	// FindPending returns refunds still waiting for the provider that were
+	// last touched before olderThan, oldest first.
+	FindPending(ctx context.Context, olderThan time.Time, limit int) ([]*Refund, error)
+	UpdateResult(ctx context.Context, id int64, status RefundStatus, reference string) error
+}
+
+type IRefundUsecase interface {
+	Refund(ctx context.Context, paymentID int64, in CreateRefund) (*RefundResult, error)
+	RetryPendingRefunds(ctx context.Context) (int64, error)
+	FindByPaymentID(ctx context.Context, paymentID int64) ([]*Refund, error)
+}
+
+// Refund gives back part or all of a settled payment. Amounts are minor units
+// in the payment currency. A refund is recorded as pending before the
+// provider is asked for the money and only counts as refunded once it
+// succeeded.
+type Refund struct {
+	ID        int64        `json:"id"`
+	PaymentID int64        `json:"payment_id"`
+	Amount    int64        `json:"amount"`
+	Currency  string       `json:"currency"`
+	Reason    string       `json:"reason,omitempty" gorm:"default:null"`
+	Reference string       `json:"reference,omitempty" gorm:"default:null"`
+	Status    RefundStatus `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// CreateRefund refunds Amount, or the whole remaining balance when Amount is
+// left empty.
+type CreateRefund struct {
+	Amount int64  `json:"amount" validate:"omitempty,gt=0"`
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+// RefundResult reports the balance after the refund. RefundedAmount only
+// counts succeeded refunds, while RemainingAmount also holds back pending ones.
+type RefundResult struct {
+	Refund          *Refund  `json:"refund"`
+	Payment         *Payment `json:"payment"`
+	RefundedAmount  int64    `json:"refunded_amount"`
+	RemainingAmount int64    `json:"remaining_amount"`
+}
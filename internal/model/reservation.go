@@ -0,0 +1,49 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "active"
+	ReservationStatusCommitted ReservationStatus = "committed"
+	ReservationStatusReleased  ReservationStatus = "released"
+)
+
+type IReservationRepository interface {
+	LockProduct(ctx context.Context, productID int64) error
+	SumActiveQuantity(ctx context.Context, productID int64, now time.Time) (int64, error)
+	Create(ctx context.Context, reservation *Reservation) error
+	AssignOrder(ctx context.Context, cartID int64, orderID string) error
+	UpdateStatusByOrderID(ctx context.Context, orderID string, from, to ReservationStatus) (int64, error)
+	UpdateStatusByCartID(ctx context.Context, cartID int64, from, to ReservationStatus) (int64, error)
+	ExtendByOrderID(ctx context.Context, orderID string, expiresAt time.Time) error
+	ReleaseExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type IReservationUsecase interface {
+	Reserve(ctx context.Context, cart *Cart) error
+	AssignOrder(ctx context.Context, cartID int64, orderID string) error
+	Commit(ctx context.Context, orderID string) error
+	Release(ctx context.Context, orderID string) error
+	Extend(ctx context.Context, orderID string, until time.Time) error
+	ReleaseCart(ctx context.Context, cartID int64) error
+	ReleaseExpired(ctx context.Context) (int64, error)
+}
+
+// Reservation holds stock for a cart line until the payment settles or the
+// reservation expires.
+type Reservation struct {
+	ID        int64             `json:"id"`
+	CartID    int64             `json:"cart_id"`
+	OrderID   string            `json:"order_id,omitempty" gorm:"default:null"`
+	ProductID int64             `json:"product_id"`
+	Quantity  int64             `json:"quantity"`
+	Status    ReservationStatus `json:"status"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
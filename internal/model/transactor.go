@@ -0,0 +1,7 @@
+package model
+
+import "context"
+
+type ITransactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// UserSegment puts a user in a named group, such as "vip" or "employee",
+// that payment method availability rules can refer to.
+type UserSegment struct {
+	UserID    int64     `json:"user_id"`
+	Segment   string    `json:"segment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type IUserSegmentRepository interface {
+	FindSegmentsByUserID(ctx context.Context, userID int64) ([]string, error)
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type WebhookEventStatus string
+
+const (
+	WebhookEventReceived  WebhookEventStatus = "received"
+	WebhookEventProcessed WebhookEventStatus = "processed"
+	WebhookEventIgnored   WebhookEventStatus = "ignored"
+	WebhookEventFailed    WebhookEventStatus = "failed"
+	WebhookEventRejected  WebhookEventStatus = "rejected"
+)
+
+var (
+	ErrWebhookProviderUnknown    = errors.New("webhooks are not configured for this provider")
+	ErrWebhookSignatureInvalid   = errors.New("webhook signature is invalid")
+	ErrWebhookPayloadInvalid     = errors.New("webhook payload is invalid")
+	ErrWebhookEventNotFound      = errors.New("webhook event not found")
+	ErrWebhookEventNotReplayable = errors.New("rejected webhook events cannot be replayed")
+)
+
+// WebhookProvider is implemented by payment providers that report outcomes
+// through callbacks. ParseWebhook translates the provider's payload, whose
+// signature has already been verified, into our payment statuses.
+type WebhookProvider interface {
+	ParseWebhook(payload []byte) (*WebhookNotification, error)
+}
+
+// WebhookNotification is a provider callback about the transaction with the
+// given reference. EventID is unique per provider and used to drop
+// redeliveries.
+//
+// Transfers into a virtual account set VirtualAccount and the transferred
+// Amount instead; Reference then holds the bank's transfer reference.
+type WebhookNotification struct {
+	EventID        string
+	Reference      string
+	Status         PaymentStatus
+	VirtualAccount string
+	Amount         int64
+}
+
+type IWebhookRepository interface {
+	// Create stores the event and reports false when the provider already
+	// sent an event with the same ID.
+	Create(ctx context.Context, event *WebhookEvent) (bool, error)
+	FindByID(ctx context.Context, id int64) (*WebhookEvent, error)
+	FindByEventID(ctx context.Context, provider, eventID string) (*WebhookEvent, error)
+	UpdateResult(ctx context.Context, id int64, status WebhookEventStatus, errMsg string) error
+}
+
+type IWebhookUsecase interface {
+	Receive(ctx context.Context, provider string, payload []byte, signature string) (*WebhookEvent, error)
+	Replay(ctx context.Context, id int64) (*WebhookEvent, error)
+}
+
+// WebhookEvent is a raw provider callback as it was received. Events with a
+// bad signature are kept as rejected, without an event ID, so they can never
+// shadow a genuine delivery.
+type WebhookEvent struct {
+	ID          int64              `json:"id"`
+	Provider    string             `json:"provider"`
+	EventID     string             `json:"event_id,omitempty" gorm:"default:null"`
+	Reference   string             `json:"reference,omitempty" gorm:"default:null"`
+	Status      WebhookEventStatus `json:"status"`
+	Signature   string             `json:"-"`
+	Payload     string             `json:"payload"`
+	Error       string             `json:"error,omitempty" gorm:"default:null"`
+	Duplicate   bool               `json:"duplicate,omitempty" gorm:"-"`
+	ProcessedAt *time.Time         `json:"processed_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Events that merchants can subscribe to.
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+	EventRefundCreated    = "refund.created"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+)
+
+// IWebhookDispatcher queues an event for every subscriber of its type. When
+// called inside a transaction the deliveries are only queued if it commits.
+type IWebhookDispatcher interface {
+	Dispatch(ctx context.Context, eventType string, data interface{}) error
+}
+
+type IWebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *WebhookSubscription) error
+	FindAll(ctx context.Context) ([]*WebhookSubscription, error)
+	FindByID(ctx context.Context, id int64) (*WebhookSubscription, error)
+	FindActiveByEventType(ctx context.Context, eventType string) ([]*WebhookSubscription, error)
+	Deactivate(ctx context.Context, id int64) (bool, error)
+}
+
+type IWebhookDeliveryRepository interface {
+	Create(ctx context.Context, deliveries []*WebhookDelivery) error
+	FindByID(ctx context.Context, id int64) (*WebhookDelivery, error)
+	FindBySubscriptionID(ctx context.Context, subscriptionID int64, limit int) ([]*WebhookDelivery, error)
+	// ClaimDue hands out pending deliveries that are due and hides them from
+	// other workers until leaseUntil.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*WebhookDelivery, error)
+	SaveAttempt(ctx context.Context, delivery *WebhookDelivery) error
+	Reset(ctx context.Context, id int64) error
+}
+
+type IWebhookSubscriptionUsecase interface {
+	IWebhookDispatcher
+	Subscribe(ctx context.Context, in CreateWebhookSubscription) (*WebhookSubscription, error)
+	FindAll(ctx context.Context) ([]*WebhookSubscription, error)
+	Unsubscribe(ctx context.Context, id int64) error
+	FindDeliveries(ctx context.Context, subscriptionID int64) ([]*WebhookDelivery, error)
+	Redeliver(ctx context.Context, deliveryID int64) (*WebhookDelivery, error)
+	DeliverDue(ctx context.Context) (int64, error)
+}
+
+// WebhookSubscription sends events of one type to a merchant URL. Payloads
+// are signed with Secret, which is only returned when the subscription is
+// created.
+type WebhookSubscription struct {
+	ID        int64     `json:"id"`
+	EventType string    `json:"event_type"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookDelivery is one event sent, or still to be sent, to one
+// subscription, together with the outcome of the last attempt.
+type WebhookDelivery struct {
+	ID               int64                 `json:"id"`
+	SubscriptionID   int64                 `json:"subscription_id"`
+	EventID          string                `json:"event_id"`
+	EventType        string                `json:"event_type"`
+	Payload          json.RawMessage       `json:"payload" gorm:"type:jsonb"`
+	Status           WebhookDeliveryStatus `json:"status"`
+	Attempts         int                   `json:"attempts"`
+	NextAttemptAt    time.Time             `json:"next_attempt_at"`
+	LastResponseCode int                   `json:"last_response_code,omitempty" gorm:"default:null"`
+	LastError        string                `json:"last_error,omitempty" gorm:"default:null"`
+	DeliveredAt      *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
+
+// WebhookEnvelope is the JSON body posted to subscribers.
+type WebhookEnvelope struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+type CreateWebhookSubscription struct {
+	EventType string `json:"event_type" validate:"required,oneof=payment.succeeded payment.failed refund.created"`
+	URL       string `json:"url" validate:"required,url,startswith=http"`
+}
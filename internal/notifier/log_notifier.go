@@ -0,0 +1,26 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// LogNotifier writes notifications to the service log instead of sending
+// them anywhere. It is the default for local development.
+type LogNotifier struct{}
+
+func NewLogNotifier() model.INotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification model.Notification) error {
+	logrus.WithFields(logrus.Fields{
+		"event":     notification.Event,
+		"recipient": notification.Recipient,
+		"subject":   notification.Subject,
+		"data":      notification.Data,
+	}).Info(notification.Body)
+	return nil
+}
@@ -0,0 +1,25 @@
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+// New returns the notifier selected by the notifier.driver config key.
+func New() (model.INotifier, error) {
+	switch config.NotifierDriver() {
+	case DriverLog:
+		return NewLogNotifier(), nil
+	case DriverSMTP:
+		return NewSMTPNotifier(config.NotifierSMTPAddr(), config.NotifierSMTPFrom()), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier driver %q", config.NotifierDriver())
+	}
+}
@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// SMTPNotifier sends notifications as plain text mail through an
+// unauthenticated SMTP relay such as a local MailHog or Mailpit instance.
+type SMTPNotifier struct {
+	addr string
+	from string
+}
+
+func NewSMTPNotifier(addr, from string) model.INotifier {
+	return &SMTPNotifier{
+		addr: addr,
+		from: from,
+	}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notification model.Notification) error {
+	if notification.Recipient == "" {
+		return errors.New("notification has no recipient")
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", notification.Recipient)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", notification.Subject)
+	fmt.Fprintf(&msg, "X-Notification-Event: %s\r\n", notification.Event)
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(notification.Body)
+
+	if err := smtp.SendMail(n.addr, nil, n.from, []string{notification.Recipient}, []byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
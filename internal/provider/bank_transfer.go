@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const BankTransferName = "bank_transfer"
+
+var errBankTransferUnsupported = errors.New("bank transfer: two-step payments are not supported")
+
+// BankTransfer collects payments as transfers into per-payment virtual
+// accounts. Charging opens the account and leaves the payment pending; the
+// bank reports the incoming transfer through a webhook. The virtual account
+// number doubles as the transaction reference.
+type BankTransfer struct {
+	accountLength int
+}
+
+func NewBankTransfer(accountLength int) model.PaymentProvider {
+	return &BankTransfer{
+		accountLength: accountLength,
+	}
+}
+
+func (b *BankTransfer) Name() string {
+	return BankTransferName
+}
+
+func (b *BankTransfer) Supports(methodType model.PaymentMethodType) bool {
+	return methodType == model.PaymentMethodBankTransfer
+}
+
+func (b *BankTransfer) VirtualAccountLength() int {
+	return b.accountLength
+}
+
+func (b *BankTransfer) Charge(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	if req.VirtualAccount == "" {
+		return nil, errors.New("bank transfer: payment has no virtual account")
+	}
+	return &model.ProviderResult{
+		Reference: req.VirtualAccount,
+		Status:    model.StatusPending,
+		Message:   "awaiting transfer",
+	}, nil
+}
+
+func (b *BankTransfer) Authorize(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	return nil, errBankTransferUnsupported
+}
+
+func (b *BankTransfer) Capture(ctx context.Context, reference string, amount int64) (*model.ProviderResult, error) {
+	return nil, errBankTransferUnsupported
+}
+
+// Void closes the virtual account. Transfers into a closed account are
+// bounced by the bank.
+func (b *BankTransfer) Void(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{Reference: reference, Status: model.StatusCancelled}, nil
+}
+
+// Refund records a refund that is paid out to the customer's account by the
+// bank's back office. The returned reference identifies the payout.
+func (b *BankTransfer) Refund(ctx context.Context, reference string, refundID int64, amount int64) (*model.ProviderResult, error) {
+	return &model.ProviderResult{
+		Reference: fmt.Sprintf("%s-rf-%d", reference, refundID),
+		Status:    model.StatusRefunded,
+	}, nil
+}
+
+// Status cannot ask the bank about a transfer; until the transfer
+// notification arrives the payment is pending.
+func (b *BankTransfer) Status(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{Reference: reference, Status: model.StatusPending}, nil
+}
+
+type bankTransferWebhook struct {
+	TransferID     string `json:"transfer_id"`
+	VirtualAccount string `json:"virtual_account"`
+	Amount         int64  `json:"amount"`
+}
+
+// ParseWebhook reads an incoming transfer notification of the form
+//
+//	{"transfer_id": "trf_1", "virtual_account": "0140000000000423", "amount": 150000}
+//
+// where amount is in minor units.
+func (b *BankTransfer) ParseWebhook(payload []byte) (*model.WebhookNotification, error) {
+	var hook bankTransferWebhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrWebhookPayloadInvalid, err)
+	}
+	if hook.TransferID == "" || hook.VirtualAccount == "" {
+		return nil, fmt.Errorf("%w: transfer_id and virtual_account are required", model.ErrWebhookPayloadInvalid)
+	}
+	if hook.Amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", model.ErrWebhookPayloadInvalid)
+	}
+
+	return &model.WebhookNotification{
+		EventID:        hook.TransferID,
+		Reference:      hook.TransferID,
+		Status:         model.StatusSuccess,
+		VirtualAccount: hook.VirtualAccount,
+		Amount:         hook.Amount,
+	}, nil
+}
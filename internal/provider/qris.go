@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const QRISName = "qris"
+
+// qrisGlobalID identifies the QRIS network in the merchant account template.
+const qrisGlobalID = "ID.CO.QRIS.WWW"
+
+var errQRISUnsupported = errors.New("qris: two-step payments are not supported")
+
+// QRISMerchant is how the merchant appears in generated QR codes.
+type QRISMerchant struct {
+	Name     string
+	City     string
+	PAN      string
+	ID       string
+	Category string
+	Country  string
+}
+
+// QRIS collects payments through dynamic EMVCo merchant-presented QR codes.
+// Charging returns the QR payload, with the amount and the payment
+// reference embedded, and leaves the payment pending until the acquirer
+// confirms it through a webhook.
+type QRIS struct {
+	merchant QRISMerchant
+}
+
+func NewQRIS(merchant QRISMerchant) model.PaymentProvider {
+	return &QRIS{
+		merchant: merchant,
+	}
+}
+
+func (q *QRIS) Name() string {
+	return QRISName
+}
+
+func (q *QRIS) Supports(methodType model.PaymentMethodType) bool {
+	return methodType == model.PaymentMethodQRIS
+}
+
+func (q *QRIS) Charge(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	currency, ok := helper.CurrencyNumericCode(req.Currency)
+	if !ok {
+		return nil, fmt.Errorf("qris: unsupported currency %q", req.Currency)
+	}
+
+	reference := fmt.Sprintf("QR%012d", req.PaymentID)
+	payload, err := helper.EncodeEMV([]helper.EMVField{
+		{Tag: helper.EMVTagPayloadFormat, Value: "01"},
+		{Tag: helper.EMVTagInitiationMethod, Value: helper.EMVInitiationMethodDynamic},
+		{Tag: helper.EMVTagMerchantAccount, Children: []helper.EMVField{
+			{Tag: "00", Value: qrisGlobalID},
+			{Tag: "01", Value: q.merchant.PAN},
+			{Tag: "02", Value: q.merchant.ID},
+		}},
+		{Tag: helper.EMVTagMerchantCategory, Value: q.merchant.Category},
+		{Tag: helper.EMVTagCurrency, Value: currency},
+		{Tag: helper.EMVTagAmount, Value: helper.FormatEMVAmount(req.Amount)},
+		{Tag: helper.EMVTagCountry, Value: q.merchant.Country},
+		{Tag: helper.EMVTagMerchantName, Value: truncate(q.merchant.Name, 25)},
+		{Tag: helper.EMVTagMerchantCity, Value: truncate(q.merchant.City, 15)},
+		{Tag: helper.EMVTagAdditionalData, Children: []helper.EMVField{
+			{Tag: helper.EMVTagAdditionalDataRef, Value: reference},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("qris: %w", err)
+	}
+
+	return &model.ProviderResult{
+		Reference: reference,
+		Status:    model.StatusPending,
+		Message:   "awaiting scan",
+		QRPayload: payload,
+	}, nil
+}
+
+func (q *QRIS) Authorize(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	return nil, errQRISUnsupported
+}
+
+func (q *QRIS) Capture(ctx context.Context, reference string, amount int64) (*model.ProviderResult, error) {
+	return nil, errQRISUnsupported
+}
+
+// Void invalidates the QR code. Dynamic codes carry a single reference, so
+// the acquirer rejects later scans of it.
+func (q *QRIS) Void(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{Reference: reference, Status: model.StatusCancelled}, nil
+}
+
+// Refund records a refund that the acquirer pays back to the customer's
+// wallet or account. The returned reference identifies the refund.
+func (q *QRIS) Refund(ctx context.Context, reference string, refundID int64, amount int64) (*model.ProviderResult, error) {
+	return &model.ProviderResult{
+		Reference: fmt.Sprintf("%s-rf-%d", reference, refundID),
+		Status:    model.StatusRefunded,
+	}, nil
+}
+
+// Status cannot ask the acquirer about a scan; until the confirmation
+// arrives the payment is pending.
+func (q *QRIS) Status(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{Reference: reference, Status: model.StatusPending}, nil
+}
+
+type qrisWebhook struct {
+	EventID   string `json:"event_id"`
+	Reference string `json:"reference"`
+	QRPayload string `json:"qr_payload"`
+}
+
+// ParseWebhook reads a payment confirmation from the acquirer. It names the
+// payment either by reference or by echoing the scanned QR payload, in which
+// case the reference embedded in the payload is used:
+//
+//	{"event_id": "evt_1", "reference": "QR000000000042"}
+//	{"event_id": "evt_1", "qr_payload": "000201010212...6304BBA1"}
+func (q *QRIS) ParseWebhook(payload []byte) (*model.WebhookNotification, error) {
+	var hook qrisWebhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrWebhookPayloadInvalid, err)
+	}
+	if hook.EventID == "" {
+		return nil, fmt.Errorf("%w: event_id is required", model.ErrWebhookPayloadInvalid)
+	}
+
+	reference := hook.Reference
+	if reference == "" && hook.QRPayload != "" {
+		embedded, err := qrisReference(hook.QRPayload)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", model.ErrWebhookPayloadInvalid, err)
+		}
+		reference = embedded
+	}
+	if reference == "" {
+		return nil, fmt.Errorf("%w: reference or qr_payload is required", model.ErrWebhookPayloadInvalid)
+	}
+
+	return &model.WebhookNotification{
+		EventID:   hook.EventID,
+		Reference: reference,
+		Status:    model.StatusSuccess,
+	}, nil
+}
+
+// qrisReference extracts the payment reference from a QR payload.
+func qrisReference(payload string) (string, error) {
+	fields, err := helper.DecodeEMV(payload)
+	if err != nil {
+		return "", err
+	}
+	additional, err := helper.DecodeEMVValues(fields[helper.EMVTagAdditionalData])
+	if err != nil {
+		return "", err
+	}
+	reference := additional[helper.EMVTagAdditionalDataRef]
+	if reference == "" {
+		return "", errors.New("qr payload has no reference")
+	}
+	return reference, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// Registry maps provider names, as stored on payment methods, to providers.
+type Registry struct {
+	providers   map[string]model.PaymentProvider
+	defaultName string
+}
+
+func NewRegistry(defaultName string, providers ...model.PaymentProvider) model.IProviderRegistry {
+	registry := &Registry{
+		providers:   make(map[string]model.PaymentProvider, len(providers)),
+		defaultName: defaultName,
+	}
+	for _, p := range providers {
+		registry.providers[p.Name()] = p
+	}
+	return registry
+}
+
+func (r *Registry) Get(name string) (model.PaymentProvider, error) {
+	if name == "" {
+		name = r.defaultName
+	}
+
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, model.ErrProviderNotFound
+	}
+	return p, nil
+}
+
+func (r *Registry) Has(name string) bool {
+	_, ok := r.providers[name]
+	return ok
+}
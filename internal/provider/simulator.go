@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const SimulatorName = "simulator"
+
+// Magic card numbers understood by the simulator.
+const (
+	SimulatorCardDeclined          = "4000000000000002"
+	SimulatorCardInsufficientFunds = "4000000000009995"
+	SimulatorCardPending           = "4000000000000259"
+)
+
+// Magic amounts are matched on the last two digits of the amount in minor
+// units, so they work for any order size.
+const (
+	simulatorAmountDeclined    = 51
+	simulatorAmountPending     = 52
+	simulatorAmountUnavailable = 53
+)
+
+var errSimulatorUnknownReference = errors.New("simulator: unknown transaction reference")
+
+// Simulator is a deterministic in-memory gateway for local development and
+// tests. Outcomes depend only on the card number and the amount:
+//
+//   - card 4000000000000002 or an amount ending in 51 is declined
+//   - card 4000000000009995 is declined for insufficient funds
+//   - card 4000000000000259 or an amount ending in 52 stays pending until
+//     its status is queried, at which point it settles as successful
+//   - an amount ending in 53 fails with ErrProviderUnavailable
+//   - anything else succeeds
+//
+// References are derived from the payment, so retrying the same call yields
+// the same reference. Transactions are kept in memory and lost on restart.
+type Simulator struct {
+	mu           sync.Mutex
+	transactions map[string]*simulatorTransaction
+}
+
+type simulatorTransaction struct {
+	status   model.PaymentStatus
+	amount   int64
+	captured int64
+	refunded int64
+	// refunds remembers the result of every refund by its ID.
+	refunds map[int64]*model.ProviderResult
+}
+
+func NewSimulator() model.PaymentProvider {
+	return &Simulator{
+		transactions: make(map[string]*simulatorTransaction),
+	}
+}
+
+func (s *Simulator) Name() string {
+	return SimulatorName
+}
+
+// Supports reports true for card and e-wallet methods.
+func (s *Simulator) Supports(methodType model.PaymentMethodType) bool {
+	return methodType == model.PaymentMethodCard || methodType == model.PaymentMethodEWallet
+}
+
+func (s *Simulator) Charge(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	return s.open(req, "ch", model.StatusSuccess)
+}
+
+func (s *Simulator) Authorize(ctx context.Context, req model.ProviderRequest) (*model.ProviderResult, error) {
+	return s.open(req, "au", model.StatusAuthorized)
+}
+
+func (s *Simulator) Capture(ctx context.Context, reference string, amount int64) (*model.ProviderResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn, ok := s.transactions[reference]
+	if !ok {
+		return nil, errSimulatorUnknownReference
+	}
+	if txn.status != model.StatusAuthorized {
+		return nil, fmt.Errorf("simulator: cannot capture a %s transaction", txn.status)
+	}
+	if amount <= 0 || amount > txn.amount {
+		return nil, fmt.Errorf("simulator: capture amount %d exceeds authorized amount %d", amount, txn.amount)
+	}
+
+	txn.status = model.StatusCaptured
+	txn.captured = amount
+	return &model.ProviderResult{Reference: reference, Status: txn.status}, nil
+}
+
+func (s *Simulator) Void(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn, ok := s.transactions[reference]
+	if !ok {
+		return nil, errSimulatorUnknownReference
+	}
+	if txn.status != model.StatusAuthorized && txn.status != model.StatusPending {
+		return nil, fmt.Errorf("simulator: cannot void a %s transaction", txn.status)
+	}
+
+	txn.status = model.StatusCancelled
+	return &model.ProviderResult{Reference: reference, Status: txn.status}, nil
+}
+
+func (s *Simulator) Refund(ctx context.Context, reference string, refundID int64, amount int64) (*model.ProviderResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn, ok := s.transactions[reference]
+	if !ok {
+		return nil, errSimulatorUnknownReference
+	}
+	if result, ok := txn.refunds[refundID]; ok {
+		return result, nil
+	}
+	if !txn.status.IsPaid() {
+		return nil, fmt.Errorf("simulator: cannot refund a %s transaction", txn.status)
+	}
+
+	settled := txn.amount
+	if txn.captured > 0 {
+		settled = txn.captured
+	}
+	if amount <= 0 || txn.refunded+amount > settled {
+		return nil, fmt.Errorf("simulator: refund of %d exceeds the remaining %d", amount, settled-txn.refunded)
+	}
+
+	txn.refunded += amount
+	txn.status = model.StatusPartiallyRefunded
+	if txn.refunded == settled {
+		txn.status = model.StatusRefunded
+	}
+	result := &model.ProviderResult{
+		Reference: fmt.Sprintf("%s_rf%d", reference, refundID),
+		Status:    txn.status,
+	}
+	if txn.refunds == nil {
+		txn.refunds = make(map[int64]*model.ProviderResult)
+	}
+	txn.refunds[refundID] = result
+	return result, nil
+}
+
+func (s *Simulator) Status(ctx context.Context, reference string) (*model.ProviderResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn, ok := s.transactions[reference]
+	if !ok {
+		return nil, errSimulatorUnknownReference
+	}
+
+	// Pending transactions settle the first time somebody asks about them.
+	if txn.status == model.StatusPending {
+		txn.status = model.StatusSuccess
+	}
+	return &model.ProviderResult{Reference: reference, Status: txn.status}, nil
+}
+
+// simulatorWebhookStatuses maps the statuses the simulator sends in its
+// callbacks onto payment statuses.
+var simulatorWebhookStatuses = map[string]model.PaymentStatus{
+	"pending":    model.StatusPending,
+	"authorized": model.StatusAuthorized,
+	"captured":   model.StatusCaptured,
+	"settled":    model.StatusSuccess,
+	"declined":   model.StatusFailed,
+	"expired":    model.StatusExpired,
+	"voided":     model.StatusCancelled,
+}
+
+type simulatorWebhook struct {
+	EventID   string `json:"event_id"`
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+}
+
+// ParseWebhook reads a simulator callback of the form
+//
+//	{"event_id": "evt_1", "reference": "sim_ch_...", "status": "settled"}
+func (s *Simulator) ParseWebhook(payload []byte) (*model.WebhookNotification, error) {
+	var hook simulatorWebhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrWebhookPayloadInvalid, err)
+	}
+	if hook.EventID == "" || hook.Reference == "" {
+		return nil, fmt.Errorf("%w: event_id and reference are required", model.ErrWebhookPayloadInvalid)
+	}
+
+	status, ok := simulatorWebhookStatuses[hook.Status]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown status %q", model.ErrWebhookPayloadInvalid, hook.Status)
+	}
+
+	return &model.WebhookNotification{
+		EventID:   hook.EventID,
+		Reference: hook.Reference,
+		Status:    status,
+	}, nil
+}
+
+func (s *Simulator) open(req model.ProviderRequest, op string, approved model.PaymentStatus) (*model.ProviderResult, error) {
+	if req.Amount%100 == simulatorAmountUnavailable {
+		return nil, model.ErrProviderUnavailable
+	}
+
+	status, message := approved, ""
+	switch {
+	case req.CardNumber == SimulatorCardDeclined, req.Amount%100 == simulatorAmountDeclined:
+		status, message = model.StatusFailed, "card declined"
+	case req.CardNumber == SimulatorCardInsufficientFunds:
+		status, message = model.StatusFailed, "insufficient funds"
+	case req.CardNumber == SimulatorCardPending, req.Amount%100 == simulatorAmountPending:
+		status = model.StatusPending
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%s|%d|%s", op, req.PaymentID, req.OrderID, req.Amount, req.Currency)))
+	reference := fmt.Sprintf("sim_%s_%s", op, hex.EncodeToString(sum[:10]))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if txn, ok := s.transactions[reference]; ok {
+		return &model.ProviderResult{Reference: reference, Status: txn.status, Message: message}, nil
+	}
+	s.transactions[reference] = &simulatorTransaction{status: status, amount: req.Amount}
+
+	return &model.ProviderResult{Reference: reference, Status: status, Message: message}, nil
+}
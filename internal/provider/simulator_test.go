@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+func TestSimulatorOutcomes(t *testing.T) {
+	tests := []struct {
+		name        string
+		authorize   bool
+		cardNumber  string
+		amount      int64
+		wantStatus  model.PaymentStatus
+		wantMessage string
+		wantErr     error
+	}{
+		{name: "charge succeeds", amount: 150000, wantStatus: model.StatusSuccess},
+		{name: "authorize succeeds", authorize: true, amount: 150000, wantStatus: model.StatusAuthorized},
+		{name: "declined card", cardNumber: SimulatorCardDeclined, amount: 150000, wantStatus: model.StatusFailed, wantMessage: "card declined"},
+		{name: "insufficient funds card", cardNumber: SimulatorCardInsufficientFunds, amount: 150000, wantStatus: model.StatusFailed, wantMessage: "insufficient funds"},
+		{name: "pending card", cardNumber: SimulatorCardPending, amount: 150000, wantStatus: model.StatusPending},
+		{name: "declined amount", amount: 150051, wantStatus: model.StatusFailed, wantMessage: "card declined"},
+		{name: "declined authorization", authorize: true, amount: 150051, wantStatus: model.StatusFailed, wantMessage: "card declined"},
+		{name: "pending amount", amount: 150052, wantStatus: model.StatusPending},
+		{name: "unavailable amount", amount: 150053, wantErr: model.ErrProviderUnavailable},
+		{name: "unavailable beats declined card", cardNumber: SimulatorCardDeclined, amount: 150053, wantErr: model.ErrProviderUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simulator := NewSimulator()
+			req := model.ProviderRequest{
+				PaymentID:  1,
+				OrderID:    "order-1",
+				Amount:     tt.amount,
+				Currency:   "IDR",
+				CardNumber: tt.cardNumber,
+			}
+
+			open := simulator.Charge
+			if tt.authorize {
+				open = simulator.Authorize
+			}
+			result, err := open(context.Background(), req)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", result.Status, tt.wantStatus)
+			}
+			if result.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", result.Message, tt.wantMessage)
+			}
+			if result.Reference == "" {
+				t.Error("reference is empty")
+			}
+		})
+	}
+}
+
+func TestSimulatorRetryKeepsReference(t *testing.T) {
+	simulator := NewSimulator()
+	req := model.ProviderRequest{PaymentID: 1, OrderID: "order-1", Amount: 150000, Currency: "IDR"}
+
+	first, err := simulator.Charge(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := simulator.Charge(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Reference != second.Reference {
+		t.Errorf("retry reference = %s, want %s", second.Reference, first.Reference)
+	}
+}
+
+func TestSimulatorPendingSettlesOnStatus(t *testing.T) {
+	simulator := NewSimulator()
+	result, err := simulator.Charge(context.Background(), model.ProviderRequest{
+		PaymentID:  1,
+		OrderID:    "order-1",
+		Amount:     150000,
+		Currency:   "IDR",
+		CardNumber: SimulatorCardPending,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	status, err := simulator.Status(context.Background(), result.Reference)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Status != model.StatusSuccess {
+		t.Errorf("status = %s, want %s", status.Status, model.StatusSuccess)
+	}
+}
+
+func TestSimulatorRefundIsKeyedByRefundID(t *testing.T) {
+	simulator := NewSimulator()
+	result, err := simulator.Charge(context.Background(), model.ProviderRequest{PaymentID: 1, OrderID: "order-1", Amount: 10000, Currency: "IDR"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first, err := simulator.Refund(context.Background(), result.Reference, 7, 4000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Status != model.StatusPartiallyRefunded {
+		t.Errorf("status = %s, want %s", first.Status, model.StatusPartiallyRefunded)
+	}
+
+	// Asking again for the same refund must not pay it out twice.
+	again, err := simulator.Refund(context.Background(), result.Reference, 7, 4000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again.Reference != first.Reference {
+		t.Errorf("retry reference = %s, want %s", again.Reference, first.Reference)
+	}
+
+	rest, err := simulator.Refund(context.Background(), result.Reference, 8, 6000)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rest.Status != model.StatusRefunded {
+		t.Errorf("status = %s, want %s", rest.Status, model.StatusRefunded)
+	}
+
+	if _, err := simulator.Refund(context.Background(), result.Reference, 9, 1); err == nil {
+		t.Error("refund beyond the paid amount succeeded")
+	}
+}
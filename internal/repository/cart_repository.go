@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+type CartRepository struct {
+	db *gorm.DB
+}
+
+func NewCartRepo(db *gorm.DB) model.ICartRepository {
+	return &CartRepository{db: db}
+}
+
+func (r *CartRepository) Create(ctx context.Context, cart *model.Cart) error {
+	return conn(ctx, r.db).Omit("Items").Create(cart).Error
+}
+
+func (r *CartRepository) FindByID(ctx context.Context, id int64) (*model.Cart, error) {
+	var cart model.Cart
+	err := conn(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("cart_items.id")
+		}).
+		Where("id = ?", id).
+		First(&cart).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	cart.SplitLists()
+	return &cart, nil
+}
+
+func (r *CartRepository) FindActiveByUserID(ctx context.Context, userID int64) (*model.Cart, error) {
+	var cart model.Cart
+	err := conn(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("cart_items.id")
+		}).
+		Where("user_id = ? AND status = ?", userID, model.CartStatusActive).
+		First(&cart).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	cart.SplitLists()
+	return &cart, nil
+}
+
+func (r *CartRepository) FindActiveByGuestToken(ctx context.Context, token string) (*model.Cart, error) {
+	var cart model.Cart
+	err := conn(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("cart_items.id")
+		}).
+		Where("guest_token = ? AND status = ?", token, model.CartStatusActive).
+		First(&cart).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	cart.SplitLists()
+	return &cart, nil
+}
+
+func (r *CartRepository) AssignUser(ctx context.Context, id int64, userID int64) error {
+	return conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"user_id":     userID,
+			"guest_token": gorm.Expr("NULL"),
+			"updated_at":  gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *CartRepository) ExpireGuestCarts(ctx context.Context, idleSince time.Time) (int64, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("guest_token IS NOT NULL AND status = ? AND updated_at < ?", model.CartStatusActive, idleSince).
+		Updates(map[string]interface{}{
+			"status":     model.CartStatusExpired,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (r *CartRepository) Touch(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ?", id).
+		Update("updated_at", gorm.Expr("NOW()")).Error
+}
+
+// UpdateCouponCode sets the cart coupon; an empty code removes it.
+func (r *CartRepository) UpdateCouponCode(ctx context.Context, id int64, code string) error {
+	var value interface{} = code
+	if code == "" {
+		value = gorm.Expr("NULL")
+	}
+
+	return conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"coupon_code": value,
+			"updated_at":  gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *CartRepository) UpdateStatus(ctx context.Context, id int64, status model.CartStatus) error {
+	return conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+// FindIdleUserCarts returns active user carts that still hold items and have
+// not been touched since idleSince, oldest first.
+func (r *CartRepository) FindIdleUserCarts(ctx context.Context, idleSince time.Time, limit int) ([]*model.Cart, error) {
+	var carts []*model.Cart
+	err := conn(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("cart_items.id")
+		}).
+		Where("user_id IS NOT NULL AND status = ? AND updated_at < ?", model.CartStatusActive, idleSince).
+		Where("EXISTS (SELECT 1 FROM cart_items WHERE cart_items.cart_id = carts.id AND cart_items.list_type = ?)", model.CartListCart).
+		Order("updated_at").
+		Limit(limit).
+		Find(&carts).Error
+	for _, cart := range carts {
+		cart.SplitLists()
+	}
+	return carts, err
+}
+
+// MarkAbandoned flips the cart to abandoned unless it was touched or left the
+// active state since it was selected.
+func (r *CartRepository) MarkAbandoned(ctx context.Context, id int64, idleSince time.Time) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ? AND status = ? AND updated_at < ?", id, model.CartStatusActive, idleSince).
+		Updates(map[string]interface{}{
+			"status":     model.CartStatusAbandoned,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *CartRepository) FindLatestAbandonedByUserID(ctx context.Context, userID int64) (*model.Cart, error) {
+	var cart model.Cart
+	err := conn(ctx, r.db).
+		Where("user_id = ? AND status = ?", userID, model.CartStatusAbandoned).
+		Order("updated_at DESC").
+		First(&cart).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// Reactivate turns an abandoned cart back into the active one, provided its
+// owner has not started a new active cart in the meantime.
+func (r *CartRepository) Reactivate(ctx context.Context, id int64) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Cart{}).
+		Where("id = ? AND status = ?", id, model.CartStatusAbandoned).
+		Where("NOT EXISTS (SELECT 1 FROM carts c WHERE c.user_id = carts.user_id AND c.status = ?)", model.CartStatusActive).
+		Updates(map[string]interface{}{
+			"status":     model.CartStatusActive,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *CartRepository) FindItemByID(ctx context.Context, cartID, itemID int64) (*model.CartItem, error) {
+	var item model.CartItem
+	err := conn(ctx, r.db).
+		Where("id = ? AND cart_id = ?", itemID, cartID).
+		First(&item).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *CartRepository) FindItemByProductID(ctx context.Context, cartID, productID int64, listType model.CartListType) (*model.CartItem, error) {
+	var item model.CartItem
+	err := conn(ctx, r.db).
+		Where("cart_id = ? AND product_id = ? AND list_type = ?", cartID, productID, listType).
+		First(&item).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *CartRepository) CreateItem(ctx context.Context, item *model.CartItem) error {
+	return conn(ctx, r.db).Create(item).Error
+}
+
+func (r *CartRepository) UpdateItemQuantity(ctx context.Context, itemID int64, quantity int64) error {
+	return conn(ctx, r.db).
+		Model(&model.CartItem{}).
+		Where("id = ?", itemID).
+		Updates(map[string]interface{}{
+			"quantity":   quantity,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *CartRepository) UpdateItemList(ctx context.Context, itemID int64, listType model.CartListType) error {
+	return conn(ctx, r.db).
+		Model(&model.CartItem{}).
+		Where("id = ?", itemID).
+		Updates(map[string]interface{}{
+			"list_type":  listType,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *CartRepository) DeleteItem(ctx context.Context, cartID, itemID int64) error {
+	return conn(ctx, r.db).
+		Where("id = ? AND cart_id = ?", itemID, cartID).
+		Delete(&model.CartItem{}).Error
+}
+
+func (r *CartRepository) DeleteItems(ctx context.Context, cartID int64, listType model.CartListType) error {
+	return conn(ctx, r.db).
+		Where("cart_id = ? AND list_type = ?", cartID, listType).
+		Delete(&model.CartItem{}).Error
+}
+
+// MoveSavedItems moves every item outside the cart list to another cart.
+func (r *CartRepository) MoveSavedItems(ctx context.Context, fromCartID, toCartID int64) error {
+	return conn(ctx, r.db).
+		Model(&model.CartItem{}).
+		Where("cart_id = ? AND list_type <> ?", fromCartID, model.CartListCart).
+		Updates(map[string]interface{}{
+			"cart_id":    toCartID,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CouponRepository struct {
+	db *gorm.DB
+}
+
+func NewCouponRepo(db *gorm.DB) model.ICouponRepository {
+	return &CouponRepository{db: db}
+}
+
+func (r *CouponRepository) FindAll(ctx context.Context, coupon model.Coupon) ([]*model.Coupon, error) {
+	var coupons []*model.Coupon
+	err := conn(ctx, r.db).
+		Where(&coupon).
+		Where("deleted_at IS NULL").
+		Order("id").
+		Find(&coupons).Error
+	return coupons, err
+}
+
+func (r *CouponRepository) FindByID(ctx context.Context, id int64) (*model.Coupon, error) {
+	var coupon model.Coupon
+	err := conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&coupon).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+func (r *CouponRepository) FindByCode(ctx context.Context, code string) (*model.Coupon, error) {
+	var coupon model.Coupon
+	err := conn(ctx, r.db).
+		Where("UPPER(code) = UPPER(?) AND deleted_at IS NULL", code).
+		First(&coupon).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// LockByCode loads the coupon with a row lock so concurrent redemptions of
+// the same code are serialised. It must be called inside a transaction.
+func (r *CouponRepository) LockByCode(ctx context.Context, code string) (*model.Coupon, error) {
+	var coupon model.Coupon
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("UPPER(code) = UPPER(?) AND deleted_at IS NULL", code).
+		First(&coupon).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+func (r *CouponRepository) Create(ctx context.Context, coupon *model.Coupon) error {
+	return conn(ctx, r.db).Create(coupon).Error
+}
+
+func (r *CouponRepository) Update(ctx context.Context, coupon model.Coupon) error {
+	return conn(ctx, r.db).
+		Model(&model.Coupon{}).
+		Where("id = ? AND deleted_at IS NULL", coupon.ID).
+		Select("code", "type", "value", "max_discount", "min_cart_value", "usage_limit", "per_user_limit", "starts_at", "ends_at", "updated_at").
+		Updates(&coupon).Error
+}
+
+func (r *CouponRepository) Delete(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).
+		Model(&model.Coupon{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Update("deleted_at", gorm.Expr("NOW()")).Error
+}
+
+func (r *CouponRepository) IncrementUsage(ctx context.Context, id int64) error {
+	res := conn(ctx, r.db).
+		Model(&model.Coupon{}).
+		Where("id = ? AND (usage_limit = 0 OR used_count < usage_limit)", id).
+		Updates(map[string]interface{}{
+			"used_count": gorm.Expr("used_count + 1"),
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return model.ErrCouponUsageExceeded
+	}
+	return nil
+}
+
+func (r *CouponRepository) CountRedemptionsByUser(ctx context.Context, couponID, userID int64) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.CouponRedemption{}).
+		Where("coupon_id = ? AND user_id = ?", couponID, userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *CouponRepository) FindRedemptionByPaymentID(ctx context.Context, paymentID int64) (*model.CouponRedemption, error) {
+	var redemption model.CouponRedemption
+	err := conn(ctx, r.db).
+		Where("payment_id = ?", paymentID).
+		First(&redemption).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &redemption, nil
+}
+
+func (r *CouponRepository) CreateRedemption(ctx context.Context, redemption *model.CouponRedemption) error {
+	return conn(ctx, r.db).Create(redemption).Error
+}
+
+func (r *CouponRepository) DeleteRedemption(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).
+		Where("id = ?", id).
+		Delete(&model.CouponRedemption{}).Error
+}
+
+func (r *CouponRepository) DecrementUsage(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).
+		Model(&model.Coupon{}).
+		Where("id = ? AND used_count > 0", id).
+		Updates(map[string]interface{}{
+			"used_count": gorm.Expr("used_count - 1"),
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
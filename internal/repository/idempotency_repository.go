@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepo(db *gorm.DB) model.IIdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Create(ctx context.Context, key *model.IdempotencyKey) (bool, error) {
+	res := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(key)
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *IdempotencyRepository) Find(ctx context.Context, scope, key string) (*model.IdempotencyKey, error) {
+	var idempotencyKey model.IdempotencyKey
+	err := conn(ctx, r.db).
+		Where("scope = ? AND key = ?", scope, key).
+		First(&idempotencyKey).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+func (r *IdempotencyRepository) SavePaymentID(ctx context.Context, scope, key string, paymentID int64) error {
+	return conn(ctx, r.db).
+		Model(&model.IdempotencyKey{}).
+		Where("scope = ? AND key = ?", scope, key).
+		Updates(map[string]interface{}{
+			"payment_id": paymentID,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, scope, key string, response json.RawMessage) error {
+	return conn(ctx, r.db).
+		Model(&model.IdempotencyKey{}).
+		Where("scope = ? AND key = ?", scope, key).
+		Updates(map[string]interface{}{
+			"response":   string(response),
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, scope, key string) error {
+	return conn(ctx, r.db).
+		Where("scope = ? AND key = ?", scope, key).
+		Delete(&model.IdempotencyKey{}).Error
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	res := conn(ctx, r.db).
+		Where("expires_at < ?", now).
+		Delete(&model.IdempotencyKey{})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepo(db *gorm.DB) model.IOutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, message *model.OutboxMessage) error {
+	return conn(ctx, r.db).Create(message).Error
+}
+
+// ClaimDue pushes the next attempt of due messages out to leaseUntil and
+// returns them. Rows locked by a concurrent claim are skipped, so several
+// relays never deliver the same message at once.
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.OutboxMessage, error) {
+	var messages []*model.OutboxMessage
+	err := conn(ctx, r.db).Raw(`
+		UPDATE outbox SET next_attempt_at = ?, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		leaseUntil, model.OutboxStatusPending, now, limit,
+	).Scan(&messages).Error
+	return messages, err
+}
+
+// SaveAttempt stores the outcome of the latest delivery attempt.
+func (r *OutboxRepository) SaveAttempt(ctx context.Context, message *model.OutboxMessage) error {
+	var lastError interface{} = message.LastError
+	if message.LastError == "" {
+		lastError = gorm.Expr("NULL")
+	}
+
+	return conn(ctx, r.db).
+		Model(&model.OutboxMessage{}).
+		Where("id = ?", message.ID).
+		Updates(map[string]interface{}{
+			"status":          message.Status,
+			"attempts":        message.Attempts,
+			"next_attempt_at": message.NextAttemptAt,
+			"last_error":      lastError,
+			"sent_at":         message.SentAt,
+			"updated_at":      gorm.Expr("NOW()"),
+		}).Error
+}
@@ -59,6 +59,7 @@ func (r *PaymentMethodRepository) Create(ctx context.Context, paymentMethod mode
 func (r *PaymentMethodRepository) Update(ctx context.Context, paymentMethod model.PaymentMethod) error {
 	err := r.db.WithContext(ctx).Model(&model.PaymentMethod{}).
 		Where("id = ? AND deleted_at IS NULL", paymentMethod.ID).
+		Select("name", "bank_code", "type", "provider", "config", "updated_at").
 		Updates(&paymentMethod).Error
 	if err != nil {
 		return err
@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaymentRepository struct {
@@ -20,13 +22,13 @@ func (r *PaymentRepository) Create(ctx context.Context, payment *model.Payment)
 	if payment.PaymentMethodID == 0 {
 		return errors.New("payment method ID is required")
 	}
-	return r.db.WithContext(ctx).Create(payment).Error
+	return conn(ctx, r.db).Create(payment).Error
 }
 
 func (r *PaymentRepository) FindAll(ctx context.Context, payment model.Payment) ([]*model.Payment, error) {
 	var payments []*model.Payment
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("PaymentMethod").
 		Where(&payment).
 		Find(&payments).Error
@@ -38,7 +40,7 @@ func (r *PaymentRepository) FindAll(ctx context.Context, payment model.Payment)
 
 func (r *PaymentRepository) FindById(ctx context.Context, id int64) (*model.Payment, error) {
 	var payment model.Payment
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("PaymentMethod").
 		Where("id = ?", id).
 		First(&payment).Error
@@ -51,18 +53,79 @@ func (r *PaymentRepository) FindById(ctx context.Context, id int64) (*model.Paym
 
 func (r *PaymentRepository) FindPaymentMethodByID(ctx context.Context, id int64) (*model.PaymentMethod, error) {
 	var paymentMethod model.PaymentMethod
-	err := r.db.Where("id = ?", id).First(&paymentMethod).Error
+	err := conn(ctx, r.db).Where("id = ?", id).First(&paymentMethod).Error
 	if err != nil {
 		return nil, err
 	}
 	return &paymentMethod, nil
 }
 
+// FindByOrderID returns the latest payment for the order, which is the one
+// that counts after a declined attempt was retried.
 func (r *PaymentRepository) FindByOrderID(ctx context.Context, orderID string) (*model.Payment, error) {
 	var payment model.Payment
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Preload("PaymentMethod").
 		Where("order_id = ?", orderID).
+		Order("created_at DESC, id DESC").
+		Take(&payment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *PaymentRepository) FindByTransactionID(ctx context.Context, transactionID string) (*model.Payment, error) {
+	var payment model.Payment
+	err := conn(ctx, r.db).
+		Preload("PaymentMethod").
+		Where("transaction_id = ?", transactionID).
+		First(&payment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *PaymentRepository) FindByVirtualAccount(ctx context.Context, virtualAccount string) (*model.Payment, error) {
+	var payment model.Payment
+	err := conn(ctx, r.db).
+		Preload("PaymentMethod").
+		Where("virtual_account = ?", virtualAccount).
+		First(&payment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// NextVirtualAccountSequence hands out the next number of the sequence that
+// keeps virtual account numbers unique.
+func (r *PaymentRepository) NextVirtualAccountSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := conn(ctx, r.db).Raw("SELECT nextval('virtual_account_seq')").Scan(&sequence).Error
+	return sequence, err
+}
+
+// LockByID loads the payment with a row lock held until the surrounding
+// transaction ends.
+func (r *PaymentRepository) LockByID(ctx context.Context, id int64) (*model.Payment, error) {
+	var payment model.Payment
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
 		First(&payment).Error
 
 	if err != nil {
@@ -74,6 +137,109 @@ func (r *PaymentRepository) FindByOrderID(ctx context.Context, orderID string) (
 	return &payment, nil
 }
 
-func (r *PaymentRepository) UpdateStatus(ctx context.Context, orderID string, status model.PaymentStatus) error {
-	return r.db.WithContext(ctx).Model(&model.Payment{}).Where("order_id = ?", orderID).Update("status", status).Error
+// UpdateStatus moves the payment from one status to another. It reports false
+// when the payment was no longer in the expected status.
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, id int64, from, to model.PaymentStatus) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *PaymentRepository) UpdateTransactionID(ctx context.Context, id int64, transactionID string) error {
+	return conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"transaction_id": transactionID,
+			"updated_at":     gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *PaymentRepository) UpdateQRPayload(ctx context.Context, id int64, qrPayload string) error {
+	return conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"qr_payload": qrPayload,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *PaymentRepository) UpdateAuthorization(ctx context.Context, id int64, amount int64, expiresAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"authorized_amount":        amount,
+			"authorization_expires_at": expiresAt,
+			"updated_at":               gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *PaymentRepository) UpdateCapturedAmount(ctx context.Context, id int64, amount int64) error {
+	return conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"captured_amount": amount,
+			"updated_at":      gorm.Expr("NOW()"),
+		}).Error
+}
+
+// FindExpiredAuthorizations returns authorized payments whose hold ran out
+// before now, oldest first.
+func (r *PaymentRepository) FindExpiredAuthorizations(ctx context.Context, now time.Time, limit int) ([]*model.Payment, error) {
+	var payments []*model.Payment
+	err := conn(ctx, r.db).
+		Preload("PaymentMethod").
+		Where("status = ? AND authorization_expires_at <= ?", model.StatusAuthorized, now).
+		Order("authorization_expires_at").
+		Limit(limit).
+		Find(&payments).Error
+	return payments, err
+}
+
+// ClaimExpiredPending pushes the next expiry attempt of pending payments
+// that expired before now out to leaseUntil and returns them. The claim is
+// committed on its own, so no lock is held while their providers are called,
+// and rows locked by a concurrent claim are skipped.
+func (r *PaymentRepository) ClaimExpiredPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.Payment, error) {
+	var ids []int64
+	err := conn(ctx, r.db).Raw(`
+		UPDATE payments SET expiry_next_attempt_at = ?, expiry_attempts = expiry_attempts + 1
+		WHERE id IN (
+			SELECT id FROM payments
+			WHERE status = ? AND expires_at <= ?
+				AND (expiry_next_attempt_at IS NULL OR expiry_next_attempt_at <= ?)
+			ORDER BY expires_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id`,
+		leaseUntil, model.StatusPending, now, now, limit,
+	).Scan(&ids).Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var payments []*model.Payment
+	err = conn(ctx, r.db).
+		Preload("PaymentMethod").
+		Where("id IN ?", ids).
+		Order("expires_at, id").
+		Find(&payments).Error
+	return payments, err
+}
+
+// DeferExpiry schedules the next try to expire the payment.
+func (r *PaymentRepository) DeferExpiry(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&model.Payment{}).
+		Where("id = ?", id).
+		Update("expiry_next_attempt_at", nextAttemptAt).Error
 }
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RefundRepository struct {
+	db *gorm.DB
+}
+
+func NewRefundRepo(db *gorm.DB) model.IRefundRepository {
+	return &RefundRepository{db: db}
+}
+
+func (r *RefundRepository) Create(ctx context.Context, refund *model.Refund) error {
+	return conn(ctx, r.db).Create(refund).Error
+}
+
+func (r *RefundRepository) FindByPaymentID(ctx context.Context, paymentID int64) ([]*model.Refund, error) {
+	var refunds []*model.Refund
+	err := conn(ctx, r.db).
+		Where("payment_id = ?", paymentID).
+		Order("id").
+		Find(&refunds).Error
+	return refunds, err
+}
+
+func (r *RefundRepository) SumByPaymentID(ctx context.Context, paymentID int64, statuses ...model.RefundStatus) (int64, error) {
+	var total int64
+	err := conn(ctx, r.db).
+		Model(&model.Refund{}).
+		Where("payment_id = ? AND status IN ?", paymentID, statuses).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *RefundRepository) LockByID(ctx context.Context, id int64) (*model.Refund, error) {
+	var refund model.Refund
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&refund).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &refund, nil
+}
+
+func (r *RefundRepository) FindPending(ctx context.Context, olderThan time.Time, limit int) ([]*model.Refund, error) {
+	var refunds []*model.Refund
+	err := conn(ctx, r.db).
+		Where("status = ? AND updated_at < ?", model.RefundStatusPending, olderThan).
+		Order("updated_at").
+		Limit(limit).
+		Find(&refunds).Error
+	return refunds, err
+}
+
+func (r *RefundRepository) UpdateResult(ctx context.Context, id int64, status model.RefundStatus, reference string) error {
+	return conn(ctx, r.db).
+		Model(&model.Refund{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"reference":  gorm.Expr("COALESCE(NULLIF(?, ''), reference)", reference),
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+// reservationLockClass namespaces the advisory locks taken per product.
+const reservationLockClass = 8001
+
+type ReservationRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationRepo(db *gorm.DB) model.IReservationRepository {
+	return &ReservationRepository{db: db}
+}
+
+// LockProduct serialises reservations of the same product until the
+// surrounding transaction ends.
+func (r *ReservationRepository) LockProduct(ctx context.Context, productID int64) error {
+	return conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(?, ?)", reservationLockClass, productID).Error
+}
+
+func (r *ReservationRepository) SumActiveQuantity(ctx context.Context, productID int64, now time.Time) (int64, error) {
+	var total int64
+	err := conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Select("COALESCE(SUM(quantity), 0)").
+		Where("product_id = ? AND status = ? AND expires_at > ?", productID, model.ReservationStatusActive, now).
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *ReservationRepository) Create(ctx context.Context, reservation *model.Reservation) error {
+	return conn(ctx, r.db).Create(reservation).Error
+}
+
+func (r *ReservationRepository) AssignOrder(ctx context.Context, cartID int64, orderID string) error {
+	return conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Where("cart_id = ? AND status = ? AND order_id IS NULL", cartID, model.ReservationStatusActive).
+		Updates(map[string]interface{}{
+			"order_id":   orderID,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *ReservationRepository) UpdateStatusByOrderID(ctx context.Context, orderID string, from, to model.ReservationStatus) (int64, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Where("order_id = ? AND status = ?", orderID, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (r *ReservationRepository) UpdateStatusByCartID(ctx context.Context, cartID int64, from, to model.ReservationStatus) (int64, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Where("cart_id = ? AND status = ?", cartID, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected, res.Error
+}
+
+// ExtendByOrderID pushes the expiry of the order's active reservations out to
+// expiresAt.
+func (r *ReservationRepository) ExtendByOrderID(ctx context.Context, orderID string, expiresAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Where("order_id = ? AND status = ?", orderID, model.ReservationStatusActive).
+		Updates(map[string]interface{}{
+			"expires_at": expiresAt,
+			"updated_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+func (r *ReservationRepository) ReleaseExpired(ctx context.Context, now time.Time) (int64, error) {
+	res := conn(ctx, r.db).
+		Model(&model.Reservation{}).
+		Where("status = ? AND expires_at <= ?", model.ReservationStatusActive, now).
+		Updates(map[string]interface{}{
+			"status":     model.ReservationStatusReleased,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+type UserSegmentRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSegmentRepo(db *gorm.DB) model.IUserSegmentRepository {
+	return &UserSegmentRepository{db: db}
+}
+
+func (r *UserSegmentRepository) FindSegmentsByUserID(ctx context.Context, userID int64) ([]string, error) {
+	var segments []string
+	err := conn(ctx, r.db).
+		Model(&model.UserSegment{}).
+		Where("user_id = ?", userID).
+		Order("segment").
+		Pluck("segment", &segments).Error
+	return segments, err
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+type WebhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepo(db *gorm.DB) model.IWebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).Create(&deliveries).Error
+}
+
+func (r *WebhookDeliveryRepository) FindByID(ctx context.Context, id int64) (*model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	err := conn(ctx, r.db).Where("id = ?", id).First(&delivery).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindBySubscriptionID returns the most recent deliveries first.
+func (r *WebhookDeliveryRepository) FindBySubscriptionID(ctx context.Context, subscriptionID int64, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := conn(ctx, r.db).
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+// ClaimDue pushes the next attempt of due deliveries out to leaseUntil and
+// returns them. Rows locked by a concurrent claim are skipped, so several
+// workers never send the same delivery at once.
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := conn(ctx, r.db).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		leaseUntil, model.WebhookDeliveryPending, now, limit,
+	).Scan(&deliveries).Error
+	return deliveries, err
+}
+
+// SaveAttempt stores the outcome of the latest attempt.
+func (r *WebhookDeliveryRepository) SaveAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	var lastError, lastResponseCode interface{} = delivery.LastError, delivery.LastResponseCode
+	if delivery.LastError == "" {
+		lastError = gorm.Expr("NULL")
+	}
+	if delivery.LastResponseCode == 0 {
+		lastResponseCode = gorm.Expr("NULL")
+	}
+
+	return conn(ctx, r.db).
+		Model(&model.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":             delivery.Status,
+			"attempts":           delivery.Attempts,
+			"next_attempt_at":    delivery.NextAttemptAt,
+			"last_response_code": lastResponseCode,
+			"last_error":         lastError,
+			"delivered_at":       delivery.DeliveredAt,
+			"updated_at":         gorm.Expr("NOW()"),
+		}).Error
+}
+
+// Reset queues the delivery to be sent again right away with a fresh retry
+// budget.
+func (r *WebhookDeliveryRepository) Reset(ctx context.Context, id int64) error {
+	return conn(ctx, r.db).
+		Model(&model.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          model.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": gorm.Expr("NOW()"),
+			"updated_at":      gorm.Expr("NOW()"),
+		}).Error
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepo(db *gorm.DB) model.IWebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, event *model.WebhookEvent) (bool, error) {
+	res := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(event)
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *WebhookRepository) FindByID(ctx context.Context, id int64) (*model.WebhookEvent, error) {
+	var event model.WebhookEvent
+	err := conn(ctx, r.db).Where("id = ?", id).First(&event).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *WebhookRepository) FindByEventID(ctx context.Context, provider, eventID string) (*model.WebhookEvent, error) {
+	var event model.WebhookEvent
+	err := conn(ctx, r.db).
+		Where("provider = ? AND event_id = ?", provider, eventID).
+		First(&event).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &event, nil
+}
+
+// UpdateResult records how processing the event ended; an empty message
+// clears a previous error.
+func (r *WebhookRepository) UpdateResult(ctx context.Context, id int64, status model.WebhookEventStatus, errMsg string) error {
+	var errValue interface{} = errMsg
+	if errMsg == "" {
+		errValue = gorm.Expr("NULL")
+	}
+
+	return conn(ctx, r.db).
+		Model(&model.WebhookEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"error":        errValue,
+			"processed_at": gorm.Expr("NOW()"),
+			"updated_at":   gorm.Expr("NOW()"),
+		}).Error
+}
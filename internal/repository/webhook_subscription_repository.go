@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	"gorm.io/gorm"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepo(db *gorm.DB) model.IWebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return conn(ctx, r.db).Create(subscription).Error
+}
+
+func (r *WebhookSubscriptionRepository) FindAll(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	var subscriptions []*model.WebhookSubscription
+	err := conn(ctx, r.db).Order("id").Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+func (r *WebhookSubscriptionRepository) FindByID(ctx context.Context, id int64) (*model.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	err := conn(ctx, r.db).Where("id = ?", id).First(&subscription).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) FindActiveByEventType(ctx context.Context, eventType string) ([]*model.WebhookSubscription, error) {
+	var subscriptions []*model.WebhookSubscription
+	err := conn(ctx, r.db).
+		Where("event_type = ? AND active", eventType).
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// Deactivate stops future deliveries to the subscription but keeps it, and
+// its delivery log, around.
+func (r *WebhookSubscriptionRepository) Deactivate(ctx context.Context, id int64) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&model.WebhookSubscription{}).
+		Where("id = ? AND active", id).
+		Updates(map[string]interface{}{
+			"active":     false,
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	return res.RowsAffected == 1, res.Error
+}
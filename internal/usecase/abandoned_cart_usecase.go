@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	pbUser "github.com/tubagusmf/ecommerce-user-product-service/pb/user"
+)
+
+type AbandonedCartUsecase struct {
+	cartRepo   model.ICartRepository
+	notifier   model.INotifier
+	userClient pbUser.UserServiceClient
+}
+
+func NewAbandonedCartUsecase(
+	cartRepo model.ICartRepository,
+	notifier model.INotifier,
+	userClient pbUser.UserServiceClient,
+) model.IAbandonedCartUsecase {
+	return &AbandonedCartUsecase{
+		cartRepo:   cartRepo,
+		notifier:   notifier,
+		userClient: userClient,
+	}
+}
+
+// DetectAbandonedCarts marks user carts idle for longer than
+// cart.abandon_after as abandoned and notifies their owners. Guest carts are
+// left to the guest cart expiry since there is nobody to remind. A failed
+// notification is logged and does not undo the status change, so a cart is
+// never reminded about twice.
+func (u *AbandonedCartUsecase) DetectAbandonedCarts(ctx context.Context) (int64, error) {
+	idleSince := time.Now().Add(-config.CartAbandonAfter())
+
+	carts, err := u.cartRepo.FindIdleUserCarts(ctx, idleSince, config.CartAbandonBatchSize())
+	if err != nil {
+		logrus.Error("Failed to find idle carts: ", err)
+		return 0, err
+	}
+
+	var abandoned int64
+	for _, cart := range carts {
+		log := logrus.WithFields(logrus.Fields{
+			"cartID": cart.ID,
+			"userID": cart.UserID,
+		})
+
+		marked, err := u.cartRepo.MarkAbandoned(ctx, cart.ID, idleSince)
+		if err != nil {
+			log.Error("Failed to mark cart as abandoned: ", err)
+			return abandoned, err
+		}
+		if !marked {
+			continue
+		}
+		abandoned++
+
+		if err := u.notify(ctx, cart); err != nil {
+			log.Error("Failed to send abandoned cart reminder: ", err)
+		}
+	}
+
+	return abandoned, nil
+}
+
+func (u *AbandonedCartUsecase) notify(ctx context.Context, cart *model.Cart) error {
+	res, err := u.userClient.GetUser(ctx, &pbUser.GetUserRequest{UserId: cart.UserID})
+	if err != nil || res == nil || res.User == nil {
+		return model.ErrInvalidUser
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hi %s,\n\nYou left these items in your cart:\n\n", res.User.Name)
+	var quantity int64
+	for _, item := range cart.Items {
+		fmt.Fprintf(&body, "- %s x%d\n", item.Name, item.Quantity)
+		quantity += item.Quantity
+	}
+	body.WriteString("\nThey are still waiting for you.\n")
+
+	return u.notifier.Notify(ctx, model.Notification{
+		Event:     model.NotificationCartAbandoned,
+		Recipient: res.User.Email,
+		Subject:   "You left something in your cart",
+		Body:      body.String(),
+		Data: map[string]interface{}{
+			"cart_id":  cart.ID,
+			"user_id":  cart.UserID,
+			"items":    len(cart.Items),
+			"quantity": quantity,
+		},
+	})
+}
@@ -0,0 +1,864 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	pbOrder "github.com/tubagusmf/ecommerce-user-product-service/pb/order"
+	pbProduct "github.com/tubagusmf/ecommerce-user-product-service/pb/product"
+	pbUser "github.com/tubagusmf/ecommerce-user-product-service/pb/user"
+)
+
+type CartUsecase struct {
+	cartRepo           model.ICartRepository
+	transactor         model.ITransactor
+	pricingEngine      model.IPricingEngine
+	promotionUsecase   model.IPromotionUsecase
+	paymentUsecase     model.IPaymentUsecase
+	reservationUsecase model.IReservationUsecase
+	productClient      pbProduct.ProductServiceClient
+	orderClient        pbOrder.OrderServiceClient
+	userClient         pbUser.UserServiceClient
+	events             model.IEventPublisher
+}
+
+func NewCartUsecase(
+	cartRepo model.ICartRepository,
+	transactor model.ITransactor,
+	pricingEngine model.IPricingEngine,
+	promotionUsecase model.IPromotionUsecase,
+	paymentUsecase model.IPaymentUsecase,
+	reservationUsecase model.IReservationUsecase,
+	productClient pbProduct.ProductServiceClient,
+	orderClient pbOrder.OrderServiceClient,
+	userClient pbUser.UserServiceClient,
+	events model.IEventPublisher,
+) model.ICartUsecase {
+	return &CartUsecase{
+		cartRepo:           cartRepo,
+		transactor:         transactor,
+		pricingEngine:      pricingEngine,
+		promotionUsecase:   promotionUsecase,
+		paymentUsecase:     paymentUsecase,
+		reservationUsecase: reservationUsecase,
+		productClient:      productClient,
+		orderClient:        orderClient,
+		userClient:         userClient,
+		events:             events,
+	}
+}
+
+func (u *CartUsecase) GetCart(ctx context.Context, id int64) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"id": id,
+	})
+
+	cart, err := u.cartRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Error("Failed to get cart: ", err)
+		return nil, err
+	}
+
+	if cart == nil {
+		return nil, model.ErrCartNotFound
+	}
+
+	return u.withPricing(ctx, cart)
+}
+
+func (u *CartUsecase) GetCartByUserID(ctx context.Context, userID int64) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"userID": userID,
+	})
+
+	cart, err := u.cartRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get cart: ", err)
+		return nil, err
+	}
+
+	if cart != nil {
+		return u.withPricing(ctx, cart)
+	}
+
+	cart, err = u.reopenAbandonedCart(ctx, userID)
+	if err != nil {
+		log.Error("Failed to reopen abandoned cart: ", err)
+		return nil, err
+	}
+
+	if cart != nil {
+		return u.withPricing(ctx, cart)
+	}
+
+	cart = &model.Cart{
+		UserID:        userID,
+		Status:        model.CartStatusActive,
+		Items:         []model.CartItem{},
+		SavedForLater: []model.CartItem{},
+		Wishlist:      []model.CartItem{},
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := u.cartRepo.Create(ctx, cart); err != nil {
+		log.Error("Failed to create cart: ", err)
+		return nil, err
+	}
+
+	return u.withPricing(ctx, cart)
+}
+
+func (u *CartUsecase) CreateGuestCart(ctx context.Context) (*model.Cart, error) {
+	token, err := newGuestToken()
+	if err != nil {
+		logrus.Error("Failed to generate guest token: ", err)
+		return nil, err
+	}
+
+	cart := &model.Cart{
+		GuestToken:    token,
+		Status:        model.CartStatusActive,
+		Items:         []model.CartItem{},
+		SavedForLater: []model.CartItem{},
+		Wishlist:      []model.CartItem{},
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	if err := u.cartRepo.Create(ctx, cart); err != nil {
+		logrus.Error("Failed to create guest cart: ", err)
+		return nil, err
+	}
+
+	return u.withPricing(ctx, cart)
+}
+
+func (u *CartUsecase) GetCartByGuestToken(ctx context.Context, token string) (*model.Cart, error) {
+	cart, err := u.cartRepo.FindActiveByGuestToken(ctx, token)
+	if err != nil {
+		logrus.Error("Failed to get guest cart: ", err)
+		return nil, err
+	}
+
+	if cart == nil {
+		return nil, model.ErrCartNotFound
+	}
+
+	return u.withPricing(ctx, cart)
+}
+
+// MergeGuestCart moves a guest cart into the user's active cart once the
+// user is known. Lines for the same product are resolved with the requested
+// policy, falling back to the configured one, and capped at available stock.
+func (u *CartUsecase) MergeGuestCart(ctx context.Context, in model.MergeCartInput) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"userID": in.UserID,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	policy := in.Policy
+	if policy == "" {
+		policy = model.MergePolicy(config.CartMergePolicy())
+	}
+	switch policy {
+	case model.MergePolicySum, model.MergePolicyKeepNewest, model.MergePolicyKeepUser:
+	default:
+		log.Error("Unknown merge policy: ", policy)
+		return nil, model.ErrInvalidMerge
+	}
+
+	user, err := u.userClient.GetUser(ctx, &pbUser.GetUserRequest{UserId: in.UserID})
+	if err != nil || user == nil || user.User == nil {
+		log.Error("Invalid user: ", err)
+		return nil, model.ErrInvalidUser
+	}
+
+	guestCart, err := u.GetCartByGuestToken(ctx, in.GuestToken)
+	if err != nil {
+		return nil, err
+	}
+
+	userCart, err := u.cartRepo.FindActiveByUserID(ctx, in.UserID)
+	if err != nil {
+		log.Error("Failed to get user cart: ", err)
+		return nil, err
+	}
+
+	if userCart == nil {
+		userCart, err = u.reopenAbandonedCart(ctx, in.UserID)
+		if err != nil {
+			log.Error("Failed to reopen abandoned cart: ", err)
+			return nil, err
+		}
+	}
+
+	// Stock is looked up before anything changes, so a product that cannot
+	// be checked aborts the merge and leaves the guest cart as it was.
+	stock := make(map[int64]int64, len(guestCart.Items))
+	for _, item := range guestCart.Items {
+		if _, ok := stock[item.ProductID]; ok {
+			continue
+		}
+		available, err := u.availableStock(ctx, item.ProductID)
+		if err != nil {
+			log.Error("Failed to check stock of product ", item.ProductID, ": ", err)
+			return nil, err
+		}
+		stock[item.ProductID] = available
+	}
+
+	if userCart == nil {
+		err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := u.cartRepo.AssignUser(ctx, guestCart.ID, in.UserID); err != nil {
+				return err
+			}
+			for _, item := range guestCart.Items {
+				var err error
+				switch available := stock[item.ProductID]; {
+				case available <= 0:
+					err = u.cartRepo.DeleteItem(ctx, guestCart.ID, item.ID)
+				case item.Quantity > available:
+					err = u.cartRepo.UpdateItemQuantity(ctx, item.ID, available)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error("Failed to assign guest cart: ", err)
+			return nil, err
+		}
+		return u.GetCart(ctx, guestCart.ID)
+	}
+
+	type lineKey struct {
+		listType  model.CartListType
+		productID int64
+	}
+	existing := make(map[lineKey]model.CartItem)
+	for _, item := range userCart.AllItems() {
+		existing[lineKey{item.ListType, item.ProductID}] = item
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, guestItem := range guestCart.AllItems() {
+			userItem, found := existing[lineKey{guestItem.ListType, guestItem.ProductID}]
+
+			quantity := guestItem.Quantity
+			if found {
+				quantity = mergeQuantity(policy, userItem, guestItem)
+			}
+
+			// Only lines headed for checkout are capped at stock.
+			if guestItem.ListType == model.CartListCart && quantity > stock[guestItem.ProductID] {
+				quantity = stock[guestItem.ProductID]
+			}
+
+			switch {
+			case found && quantity <= 0:
+				err = u.cartRepo.DeleteItem(ctx, userCart.ID, userItem.ID)
+			case found:
+				err = u.cartRepo.UpdateItemQuantity(ctx, userItem.ID, quantity)
+			case quantity > 0:
+				err = u.cartRepo.CreateItem(ctx, &model.CartItem{
+					CartID:    userCart.ID,
+					ProductID: guestItem.ProductID,
+					Name:      guestItem.Name,
+					UnitPrice: guestItem.UnitPrice,
+					Quantity:  quantity,
+					ListType:  guestItem.ListType,
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				})
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := u.cartRepo.UpdateStatus(ctx, guestCart.ID, model.CartStatusMerged); err != nil {
+			return err
+		}
+		return u.cartRepo.Touch(ctx, userCart.ID)
+	})
+	if err != nil {
+		log.Error("Failed to merge guest cart: ", err)
+		return nil, err
+	}
+
+	return u.GetCart(ctx, userCart.ID)
+}
+
+// ExpireGuestCarts marks guest carts that have been idle for longer than the
+// configured TTL as expired.
+func (u *CartUsecase) ExpireGuestCarts(ctx context.Context) (int64, error) {
+	n, err := u.cartRepo.ExpireGuestCarts(ctx, time.Now().Add(-config.CartGuestTTL()))
+	if err != nil {
+		logrus.Error("Failed to expire guest carts: ", err)
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (u *CartUsecase) AddItem(ctx context.Context, in model.AddCartItemInput) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"in": in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	var cart *model.Cart
+	var err error
+	if in.GuestToken != "" {
+		cart, err = u.GetCartByGuestToken(ctx, in.GuestToken)
+	} else {
+		cart, err = u.GetCartByUserID(ctx, in.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	listType := in.ListType
+	if listType == "" {
+		listType = model.CartListCart
+	}
+
+	item, err := u.cartRepo.FindItemByProductID(ctx, cart.ID, in.ProductID, listType)
+	if err != nil {
+		log.Error("Failed to find cart item: ", err)
+		return nil, err
+	}
+
+	quantity := in.Quantity
+	if item != nil {
+		quantity += item.Quantity
+	}
+
+	product, err := u.checkListStock(ctx, in.ProductID, quantity, listType)
+	if err != nil {
+		log.Error("Failed to add cart item: ", err)
+		return nil, err
+	}
+
+	if item != nil {
+		err = u.cartRepo.UpdateItemQuantity(ctx, item.ID, quantity)
+	} else {
+		err = u.cartRepo.CreateItem(ctx, &model.CartItem{
+			CartID:    cart.ID,
+			ProductID: product.ProductId,
+			Name:      product.Name,
+			UnitPrice: helper.ToMinorUnits(product.Price),
+			Quantity:  quantity,
+			ListType:  listType,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		})
+	}
+	if err != nil {
+		log.Error("Failed to save cart item: ", err)
+		return nil, err
+	}
+
+	return u.touchAndReload(ctx, cart.ID)
+}
+
+func (u *CartUsecase) UpdateItem(ctx context.Context, cartID, itemID int64, in model.UpdateCartItemInput) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+		"itemID": itemID,
+		"in":     in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	item, err := u.findActiveItem(ctx, cartID, itemID)
+	if err != nil {
+		log.Error("Failed to find cart item: ", err)
+		return nil, err
+	}
+
+	if _, err := u.checkListStock(ctx, item.ProductID, in.Quantity, item.ListType); err != nil {
+		log.Error("Failed to update cart item: ", err)
+		return nil, err
+	}
+
+	if err := u.cartRepo.UpdateItemQuantity(ctx, item.ID, in.Quantity); err != nil {
+		log.Error("Failed to update cart item: ", err)
+		return nil, err
+	}
+
+	return u.touchAndReload(ctx, cartID)
+}
+
+func (u *CartUsecase) RemoveItem(ctx context.Context, cartID, itemID int64) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+		"itemID": itemID,
+	})
+
+	if _, err := u.findActiveItem(ctx, cartID, itemID); err != nil {
+		log.Error("Failed to find cart item: ", err)
+		return nil, err
+	}
+
+	if err := u.cartRepo.DeleteItem(ctx, cartID, itemID); err != nil {
+		log.Error("Failed to remove cart item: ", err)
+		return nil, err
+	}
+
+	return u.touchAndReload(ctx, cartID)
+}
+
+// MoveItem moves an item to another list of the same cart. When the target
+// list already holds the product, the quantities are added together.
+func (u *CartUsecase) MoveItem(ctx context.Context, cartID, itemID int64, in model.MoveCartItemInput) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+		"itemID": itemID,
+		"in":     in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	item, err := u.findActiveItem(ctx, cartID, itemID)
+	if err != nil {
+		log.Error("Failed to find cart item: ", err)
+		return nil, err
+	}
+
+	if item.ListType == in.ListType {
+		return u.GetCart(ctx, cartID)
+	}
+
+	target, err := u.cartRepo.FindItemByProductID(ctx, cartID, item.ProductID, in.ListType)
+	if err != nil {
+		log.Error("Failed to find cart item: ", err)
+		return nil, err
+	}
+
+	quantity := item.Quantity
+	if target != nil {
+		quantity += target.Quantity
+	}
+
+	if _, err := u.checkListStock(ctx, item.ProductID, quantity, in.ListType); err != nil {
+		log.Error("Failed to move cart item: ", err)
+		return nil, err
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if target == nil {
+			return u.cartRepo.UpdateItemList(ctx, item.ID, in.ListType)
+		}
+		if err := u.cartRepo.UpdateItemQuantity(ctx, target.ID, quantity); err != nil {
+			return err
+		}
+		return u.cartRepo.DeleteItem(ctx, cartID, item.ID)
+	})
+	if err != nil {
+		log.Error("Failed to move cart item: ", err)
+		return nil, err
+	}
+
+	return u.touchAndReload(ctx, cartID)
+}
+
+func (u *CartUsecase) ClearCart(ctx context.Context, cartID int64) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+	})
+
+	if _, err := u.findActiveCart(ctx, cartID); err != nil {
+		log.Error("Failed to find cart: ", err)
+		return nil, err
+	}
+
+	if err := u.cartRepo.DeleteItems(ctx, cartID, model.CartListCart); err != nil {
+		log.Error("Failed to clear cart: ", err)
+		return nil, err
+	}
+
+	return u.touchAndReload(ctx, cartID)
+}
+
+func (u *CartUsecase) PriceCart(ctx context.Context, cartID int64) (*model.PriceBreakdown, error) {
+	cart, err := u.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.Pricing, nil
+}
+
+func (u *CartUsecase) ApplyCoupon(ctx context.Context, cartID int64, in model.ApplyCouponInput) (*model.Cart, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+		"in":     in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	cart, err := u.findActiveCart(ctx, cartID)
+	if err != nil {
+		log.Error("Failed to find cart: ", err)
+		return nil, err
+	}
+
+	coupon, err := u.promotionUsecase.ValidateCoupon(ctx, in.Code, cart.UserID, cart.Pricing.Subtotal)
+	if err != nil {
+		log.Error("Coupon rejected: ", err)
+		return nil, err
+	}
+
+	if err := u.cartRepo.UpdateCouponCode(ctx, cartID, coupon.Code); err != nil {
+		log.Error("Failed to apply coupon: ", err)
+		return nil, err
+	}
+
+	return u.GetCart(ctx, cartID)
+}
+
+func (u *CartUsecase) RemoveCoupon(ctx context.Context, cartID int64) (*model.Cart, error) {
+	if _, err := u.findActiveCart(ctx, cartID); err != nil {
+		logrus.WithField("cartID", cartID).Error("Failed to find cart: ", err)
+		return nil, err
+	}
+
+	if err := u.cartRepo.UpdateCouponCode(ctx, cartID, ""); err != nil {
+		logrus.WithField("cartID", cartID).Error("Failed to remove coupon: ", err)
+		return nil, err
+	}
+
+	return u.GetCart(ctx, cartID)
+}
+
+// Checkout turns the cart into an order and a pending payment. Stock is
+// reserved before the order is created and released again if any later step
+// fails. When the payment cannot be written or is declined, the order is
+// compensated with an order.cancel_requested event, which goes through the
+// outbox so the request survives a crash and reaches the order service.
+func (u *CartUsecase) Checkout(ctx context.Context, cartID int64, in model.CheckoutInput) (*model.CheckoutResult, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cartID,
+		"in":     in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	cart, err := u.findActiveCart(ctx, cartID)
+	if err != nil {
+		log.Error("Failed to find cart: ", err)
+		return nil, err
+	}
+
+	if cart.UserID == 0 {
+		return nil, model.ErrGuestCartCheckout
+	}
+
+	if len(cart.Items) == 0 {
+		return nil, model.ErrCartEmpty
+	}
+
+	paymentMethod, err := u.paymentUsecase.GetPaymentMethodByID(ctx, in.PaymentMethodID)
+	if err != nil {
+		log.Error("Failed to find payment method: ", err)
+		return nil, err
+	}
+
+	// The order is placed at the current product prices, so the cart is
+	// repriced from the same products before its breakdown is charged.
+	orderItems := make([]*pbOrder.OrderItem, 0, len(cart.Items))
+	for i, item := range cart.Items {
+		product, err := u.checkStock(ctx, item.ProductID, item.Quantity)
+		if err != nil {
+			log.Error("Cart validation failed: ", err)
+			return nil, err
+		}
+
+		cart.Items[i].UnitPrice = helper.ToMinorUnits(product.Price)
+		orderItems = append(orderItems, &pbOrder.OrderItem{
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     product.Price,
+		})
+	}
+
+	cart, err = u.withPricing(ctx, cart)
+	if err != nil {
+		log.Error("Failed to price cart: ", err)
+		return nil, err
+	}
+
+	if err := u.reservationUsecase.Reserve(ctx, cart); err != nil {
+		log.Error("Failed to reserve stock: ", err)
+		return nil, err
+	}
+
+	orderRes, err := u.orderClient.CreateOrder(ctx, &pbOrder.CreateOrderRequest{
+		UserId: cart.UserID,
+		Items:  orderItems,
+	})
+	if err != nil || orderRes == nil || orderRes.Order == nil {
+		log.Error("Failed to create order: ", err)
+		u.releaseCartReservations(ctx, cart.ID)
+		return nil, fmt.Errorf("%w: failed to create order", model.ErrCheckoutFailed)
+	}
+	orderID := orderRes.Order.OrderId
+
+	if err := u.reservationUsecase.AssignOrder(ctx, cart.ID, orderID); err != nil {
+		log.Error("Failed to link reservations to order ", orderID, ": ", err)
+	}
+
+	details := model.PaymentDetails{CardNumber: in.CardNumber}
+	var payment *model.Payment
+	if in.AuthorizeOnly {
+		payment, err = u.paymentUsecase.AuthorizePayment(ctx, orderID, cart.UserID, *paymentMethod, cart.Pricing, details)
+	} else {
+		payment, err = u.paymentUsecase.ProcessPayment(ctx, orderID, cart.UserID, *paymentMethod, cart.Pricing, details)
+	}
+	if err != nil {
+		log.Error("Failed to create payment for order ", orderID, ": ", err)
+		u.releaseCartReservations(ctx, cart.ID)
+		u.requestOrderCancellation(ctx, cart, orderID, "payment failed")
+		return nil, fmt.Errorf("%w: %w", model.ErrCheckoutFailed, err)
+	}
+
+	// A declined payment has already released its reservations. The cart
+	// stays active so the user can try another card, which places a new
+	// order, so this one is cancelled.
+	if payment.Status == model.StatusFailed {
+		log.Error("Payment declined for order ", orderID)
+		u.requestOrderCancellation(ctx, cart, orderID, "payment declined")
+		return nil, model.ErrPaymentDeclined
+	}
+
+	// The order and payment already exist at this point, so a failure here
+	// must not be reported as a failed checkout or the client would retry it.
+	if err := u.closeCheckedOutCart(ctx, cart, payment); err != nil {
+		log.Error("Failed to mark cart as checked out: ", err)
+	}
+
+	return &model.CheckoutResult{
+		CartID:  cart.ID,
+		OrderID: orderID,
+		Payment: payment,
+	}, nil
+}
+
+// closeCheckedOutCart marks the cart checked out, publishes the checkout and
+// carries its saved and wishlist items over to a fresh active cart for the
+// same user.
+func (u *CartUsecase) closeCheckedOutCart(ctx context.Context, cart *model.Cart, payment *model.Payment) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.cartRepo.UpdateStatus(ctx, cart.ID, model.CartStatusCheckedOut); err != nil {
+			return err
+		}
+
+		err := u.events.Publish(ctx, model.EventTypeCartCheckedOut, model.CartCheckedOutEvent{
+			CartID:    cart.ID,
+			UserID:    cart.UserID,
+			OrderID:   payment.OrderID,
+			PaymentID: payment.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(cart.SavedForLater) == 0 && len(cart.Wishlist) == 0 {
+			return nil
+		}
+
+		next := &model.Cart{
+			UserID:    cart.UserID,
+			Status:    model.CartStatusActive,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		if err := u.cartRepo.Create(ctx, next); err != nil {
+			return err
+		}
+		return u.cartRepo.MoveSavedItems(ctx, cart.ID, next.ID)
+	})
+}
+
+// requestOrderCancellation records the compensation for an order whose
+// checkout failed after the order was created.
+func (u *CartUsecase) requestOrderCancellation(ctx context.Context, cart *model.Cart, orderID, reason string) {
+	err := u.events.Publish(ctx, model.EventTypeOrderCancelRequested, model.OrderCancelRequestedEvent{
+		OrderID: orderID,
+		CartID:  cart.ID,
+		UserID:  cart.UserID,
+		Reason:  reason,
+	})
+	if err != nil {
+		logrus.WithField("orderID", orderID).Error("Failed to request order cancellation: ", err)
+	}
+}
+
+func (u *CartUsecase) releaseCartReservations(ctx context.Context, cartID int64) {
+	if err := u.reservationUsecase.ReleaseCart(ctx, cartID); err != nil {
+		logrus.WithField("cartID", cartID).Error("Failed to release reservations: ", err)
+	}
+}
+
+func (u *CartUsecase) findActiveCart(ctx context.Context, cartID int64) (*model.Cart, error) {
+	cart, err := u.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An abandoned cart comes back to life as soon as its owner works on it
+	// again, e.g. after following a reminder.
+	if cart.Status == model.CartStatusAbandoned {
+		reactivated, err := u.cartRepo.Reactivate(ctx, cart.ID)
+		if err != nil {
+			return nil, err
+		}
+		if reactivated {
+			cart.Status = model.CartStatusActive
+		}
+	}
+
+	if cart.Status != model.CartStatusActive {
+		return nil, model.ErrCartNotActive
+	}
+
+	return cart, nil
+}
+
+// reopenAbandonedCart reactivates the user's most recently abandoned cart.
+// It returns nil when there is none to reopen.
+func (u *CartUsecase) reopenAbandonedCart(ctx context.Context, userID int64) (*model.Cart, error) {
+	abandoned, err := u.cartRepo.FindLatestAbandonedByUserID(ctx, userID)
+	if err != nil || abandoned == nil {
+		return nil, err
+	}
+
+	reactivated, err := u.cartRepo.Reactivate(ctx, abandoned.ID)
+	if err != nil || !reactivated {
+		return nil, err
+	}
+
+	return u.cartRepo.FindByID(ctx, abandoned.ID)
+}
+
+func (u *CartUsecase) findActiveItem(ctx context.Context, cartID, itemID int64) (*model.CartItem, error) {
+	if _, err := u.findActiveCart(ctx, cartID); err != nil {
+		return nil, err
+	}
+
+	item, err := u.cartRepo.FindItemByID(ctx, cartID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, model.ErrCartItemNotFound
+	}
+
+	return item, nil
+}
+
+// availableStock returns the stock the product service reports for productID.
+func (u *CartUsecase) availableStock(ctx context.Context, productID int64) (int64, error) {
+	res, err := u.productClient.GetProduct(ctx, &pbProduct.GetProductRequest{ProductId: productID})
+	if err != nil || res == nil || res.Product == nil {
+		return 0, model.ErrInvalidProduct
+	}
+
+	return res.Product.Stock, nil
+}
+
+// checkListStock checks stock only for items headed for checkout; other
+// lists just need the product to exist.
+func (u *CartUsecase) checkListStock(ctx context.Context, productID, quantity int64, listType model.CartListType) (*pbProduct.Product, error) {
+	if listType == model.CartListCart {
+		return u.checkStock(ctx, productID, quantity)
+	}
+	return u.checkStock(ctx, productID, 0)
+}
+
+// checkStock fetches the product from the product service and makes sure
+// the requested quantity is available.
+func (u *CartUsecase) checkStock(ctx context.Context, productID, quantity int64) (*pbProduct.Product, error) {
+	res, err := u.productClient.GetProduct(ctx, &pbProduct.GetProductRequest{ProductId: productID})
+	if err != nil || res == nil || res.Product == nil {
+		return nil, model.ErrInvalidProduct
+	}
+
+	if res.Product.Stock < quantity {
+		return nil, model.ErrInsufficientStock
+	}
+
+	return res.Product, nil
+}
+
+func (u *CartUsecase) withPricing(ctx context.Context, cart *model.Cart) (*model.Cart, error) {
+	breakdown, err := u.pricingEngine.Price(ctx, cart)
+	if err != nil {
+		logrus.WithField("cartID", cart.ID).Error("Failed to price cart: ", err)
+		return nil, err
+	}
+
+	cart.Pricing = breakdown
+	return cart, nil
+}
+
+func (u *CartUsecase) touchAndReload(ctx context.Context, cartID int64) (*model.Cart, error) {
+	if err := u.cartRepo.Touch(ctx, cartID); err != nil {
+		return nil, err
+	}
+	return u.GetCart(ctx, cartID)
+}
+
+func mergeQuantity(policy model.MergePolicy, userItem, guestItem model.CartItem) int64 {
+	switch policy {
+	case model.MergePolicyKeepNewest:
+		if guestItem.UpdatedAt.After(userItem.UpdatedAt) {
+			return guestItem.Quantity
+		}
+		return userItem.Quantity
+	case model.MergePolicyKeepUser:
+		return userItem.Quantity
+	default:
+		return userItem.Quantity + guestItem.Quantity
+	}
+}
+
+func newGuestToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
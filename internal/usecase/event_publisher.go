@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// OutboxEventPublisher records domain events in the outbox, inside the
+// caller's transaction, and leaves it to the relay to put them on the bus.
+// An event is therefore published if and only if its change is committed.
+type OutboxEventPublisher struct {
+	outboxRepo model.IOutboxRepository
+}
+
+func NewOutboxEventPublisher(outboxRepo model.IOutboxRepository) model.IEventPublisher {
+	return &OutboxEventPublisher{
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (p *OutboxEventPublisher) Publish(ctx context.Context, eventType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	id, err := randomHex(16)
+	if err != nil {
+		return err
+	}
+
+	event := model.Event{
+		ID:         id,
+		Type:       eventType,
+		Version:    model.EventVersion,
+		OccurredAt: time.Now().UTC(),
+		Payload:    data,
+	}
+	message, err := model.NewOutboxMessage(model.OutboxTopicDomainEvent, event.ID, event)
+	if err != nil {
+		return err
+	}
+	return p.outboxRepo.Create(ctx, message)
+}
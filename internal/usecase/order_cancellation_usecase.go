@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+const orderCancelledRefundReason = "order cancelled"
+
+// OrderCancellationUsecase settles the payment of an order the order service
+// cancelled: unsettled payments are voided and settled ones are refunded.
+type OrderCancellationUsecase struct {
+	paymentUsecase model.IPaymentUsecase
+	refundUsecase  model.IRefundUsecase
+}
+
+func NewOrderCancellationUsecase(
+	paymentUsecase model.IPaymentUsecase,
+	refundUsecase model.IRefundUsecase,
+) model.IOrderCancellationUsecase {
+	return &OrderCancellationUsecase{
+		paymentUsecase: paymentUsecase,
+		refundUsecase:  refundUsecase,
+	}
+}
+
+// HandleOrderCancelled voids or refunds the order's payment. It is safe to
+// call again for the same order: once the payment has reached a final status
+// nothing more is done, which also covers a concurrent call that got there
+// first.
+func (u *OrderCancellationUsecase) HandleOrderCancelled(ctx context.Context, orderID, reason string) (*model.OrderCancellationResult, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"orderID": orderID,
+		"reason":  reason,
+	})
+
+	payment, err := u.paymentUsecase.GetPaymentByOrderID(ctx, orderID)
+	if err != nil {
+		log.Error("Failed to find payment: ", err)
+		return nil, err
+	}
+	if payment == nil {
+		return nil, model.ErrPaymentNotFound
+	}
+
+	result, err := u.settle(ctx, payment, reason)
+	if errors.Is(err, model.ErrRefundExceedsBalance) {
+		// The whole balance is already refunded or has a refund pending.
+		result, err = &model.OrderCancellationResult{Action: model.OrderCancellationNone, Payment: payment}, nil
+	}
+	if err != nil {
+		if !errors.Is(err, model.ErrInvalidPaymentTransition) && !errors.Is(err, model.ErrPaymentNotRefundable) {
+			log.Error("Failed to settle payment of cancelled order: ", err)
+			return nil, err
+		}
+
+		current, findErr := u.paymentUsecase.GetPaymentByID(ctx, payment.ID)
+		if findErr != nil || current == nil || !current.Status.IsFinal() {
+			log.Error("Failed to settle payment of cancelled order: ", err)
+			return nil, err
+		}
+		result = &model.OrderCancellationResult{Action: model.OrderCancellationNone, Payment: current}
+	}
+
+	log.WithField("paymentID", result.Payment.ID).Info("Payment of cancelled order handled: ", result.Action)
+	return result, nil
+}
+
+func (u *OrderCancellationUsecase) settle(ctx context.Context, payment *model.Payment, reason string) (*model.OrderCancellationResult, error) {
+	switch {
+	case payment.Status == model.StatusPending || payment.Status == model.StatusAuthorized:
+		cancelled, err := u.paymentUsecase.CancelPayment(ctx, payment.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OrderCancellationResult{Action: model.OrderCancellationCancelled, Payment: cancelled}, nil
+
+	case payment.Status.IsPaid() && !payment.Status.IsFinal():
+		if reason == "" {
+			reason = orderCancelledRefundReason
+		}
+		refunded, err := u.refundUsecase.Refund(ctx, payment.ID, model.CreateRefund{Reason: reason})
+		if err != nil {
+			return nil, err
+		}
+		return &model.OrderCancellationResult{
+			Action:  model.OrderCancellationRefunded,
+			Payment: refunded.Payment,
+			Refund:  refunded.Refund,
+		}, nil
+	}
+
+	return &model.OrderCancellationResult{Action: model.OrderCancellationNone, Payment: payment}, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	pbOrder "github.com/tubagusmf/ecommerce-user-product-service/pb/order"
+)
+
+// OutboxRelay delivers outbox messages to the handler registered for their
+// topic, retrying failures with exponential backoff.
+type OutboxRelay struct {
+	outboxRepo model.IOutboxRepository
+	handlers   map[string]model.OutboxHandler
+}
+
+// NewOutboxRelay returns a relay that already knows how to deliver the
+// order service messages and domain events.
+func NewOutboxRelay(
+	outboxRepo model.IOutboxRepository,
+	orderClient pbOrder.OrderServiceClient,
+	eventBus model.IEventBus,
+) model.IOutboxRelay {
+	relay := &OutboxRelay{
+		outboxRepo: outboxRepo,
+		handlers:   make(map[string]model.OutboxHandler),
+	}
+	relay.Handle(model.OutboxTopicOrderPaid, markOrderPaidHandler(orderClient))
+	relay.Handle(model.OutboxTopicDomainEvent, publishEventHandler(eventBus))
+	return relay
+}
+
+func (r *OutboxRelay) Handle(topic string, handler model.OutboxHandler) {
+	r.handlers[topic] = handler
+}
+
+// Relay delivers a batch of due messages and reports how many went out.
+func (r *OutboxRelay) Relay(ctx context.Context) (int64, error) {
+	now := time.Now()
+	messages, err := r.outboxRepo.ClaimDue(ctx, now, now.Add(config.OutboxLease()), config.OutboxBatchSize())
+	if err != nil {
+		logrus.Error("Failed to claim outbox messages: ", err)
+		return 0, err
+	}
+
+	var sent int64
+	for _, message := range messages {
+		log := logrus.WithFields(logrus.Fields{
+			"outboxID": message.ID,
+			"topic":    message.Topic,
+			"key":      message.Key,
+		})
+
+		r.deliver(ctx, message)
+		if err := r.outboxRepo.SaveAttempt(ctx, message); err != nil {
+			log.Error("Failed to record outbox attempt: ", err)
+			continue
+		}
+
+		switch message.Status {
+		case model.OutboxStatusSent:
+			sent++
+		case model.OutboxStatusFailed:
+			log.Error("Giving up on outbox message after ", message.Attempts, " attempts: ", message.LastError)
+		default:
+			log.Warn("Outbox attempt ", message.Attempts, " failed: ", message.LastError)
+		}
+	}
+	return sent, nil
+}
+
+func (r *OutboxRelay) deliver(ctx context.Context, message *model.OutboxMessage) {
+	message.Attempts++
+
+	handler, ok := r.handlers[message.Topic]
+	if !ok {
+		message.Status = model.OutboxStatusFailed
+		message.LastError = fmt.Sprintf("no handler for topic %q", message.Topic)
+		return
+	}
+
+	if err := handler(ctx, message); err != nil {
+		message.LastError = err.Error()
+		if message.Attempts >= config.OutboxMaxAttempts() {
+			message.Status = model.OutboxStatusFailed
+			return
+		}
+		message.NextAttemptAt = time.Now().Add(helper.Backoff(message.Attempts, config.OutboxBackoff(), config.OutboxMaxBackoff()))
+		return
+	}
+
+	now := time.Now()
+	message.Status = model.OutboxStatusSent
+	message.LastError = ""
+	message.SentAt = &now
+}
+
+func markOrderPaidHandler(orderClient pbOrder.OrderServiceClient) model.OutboxHandler {
+	return func(ctx context.Context, message *model.OutboxMessage) error {
+		var paid model.OrderPaidMessage
+		if err := json.Unmarshal(message.Payload, &paid); err != nil {
+			return err
+		}
+		_, err := orderClient.MarkOrderPaid(ctx, &pbOrder.MarkOrderPaidRequest{OrderId: paid.OrderID})
+		return err
+	}
+}
+
+func publishEventHandler(eventBus model.IEventBus) model.OutboxHandler {
+	return func(ctx context.Context, message *model.OutboxMessage) error {
+		var event model.Event
+		if err := json.Unmarshal(message.Payload, &event); err != nil {
+			return err
+		}
+		return eventBus.Publish(ctx, &event)
+	}
+}
@@ -3,20 +3,28 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 )
 
 type PaymentMethodUsecase struct {
 	paymentMethodRepo model.IPaymentMethodRepository
+	userSegmentRepo   model.IUserSegmentRepository
+	providers         model.IProviderRegistry
 }
 
-func NewPaymentMethodUsecase(paymentMethodRepo model.IPaymentMethodRepository) model.IPaymentMethodUsecase {
-	return &PaymentMethodUsecase{paymentMethodRepo: paymentMethodRepo}
+func NewPaymentMethodUsecase(paymentMethodRepo model.IPaymentMethodRepository, userSegmentRepo model.IUserSegmentRepository, providers model.IProviderRegistry) model.IPaymentMethodUsecase {
+	return &PaymentMethodUsecase{
+		paymentMethodRepo: paymentMethodRepo,
+		userSegmentRepo:   userSegmentRepo,
+		providers:         providers,
+	}
 }
 
 func (u *PaymentMethodUsecase) FindAll(ctx context.Context, paymentMethod model.PaymentMethod) ([]*model.PaymentMethod, error) {
@@ -47,13 +55,73 @@ func (u *PaymentMethodUsecase) FindByID(ctx context.Context, id int64) (*model.P
 	return paymentMethod, nil
 }
 
+// FindAvailable lists the methods whose availability rules allow the
+// checkout described by the query.
+func (u *PaymentMethodUsecase) FindAvailable(ctx context.Context, query model.AvailabilityQuery) ([]*model.PaymentMethod, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"query": query,
+	})
+
+	if err := helper.Validator.Struct(query); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	var segments []string
+	if query.UserID != 0 {
+		var err error
+		segments, err = u.userSegmentRepo.FindSegmentsByUserID(ctx, query.UserID)
+		if err != nil {
+			log.Error("Failed to get user segments: ", err)
+			return nil, err
+		}
+	}
+
+	paymentMethods, err := u.paymentMethodRepo.FindAll(ctx, model.PaymentMethod{})
+	if err != nil {
+		log.Error("Failed to get payment methods: ", err)
+		return nil, err
+	}
+
+	now := time.Now()
+	available := make([]*model.PaymentMethod, 0, len(paymentMethods))
+	for _, paymentMethod := range paymentMethods {
+		if paymentMethod.Config.AvailableFor(query, segments, config.PricingCurrency(), now) {
+			available = append(available, paymentMethod)
+		}
+	}
+
+	return available, nil
+}
+
 func (u *PaymentMethodUsecase) Create(ctx context.Context, in model.CreatePaymentMethod) error {
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Println("Validation error:", err)
+		return err
+	}
+
+	provider := in.Provider
+	if provider == "" {
+		provider = config.PaymentDefaultProvider()
+	}
+	if !u.providers.Has(provider) {
+		log.Println("Unknown payment provider:", provider)
+		return model.ErrProviderNotFound
+	}
+
 	paymentMethod := model.PaymentMethod{
 		Name:      in.Name,
 		BankCode:  in.BankCode,
+		Type:      in.Type,
+		Provider:  provider,
+		Config:    in.Config,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
+	if err := u.validateMethod(paymentMethod); err != nil {
+		log.Println("Invalid payment method:", err)
+		return err
+	}
 
 	err := u.paymentMethodRepo.Create(ctx, paymentMethod)
 	if err != nil {
@@ -76,6 +144,11 @@ func (u *PaymentMethodUsecase) Update(ctx context.Context, id int64, in model.Up
 		return err
 	}
 
+	if in.Provider != "" && !u.providers.Has(in.Provider) {
+		log.Error("Unknown payment provider: ", in.Provider)
+		return model.ErrProviderNotFound
+	}
+
 	paymentMethod, err := u.paymentMethodRepo.FindByID(ctx, id)
 	if err != nil {
 		return errors.New("payment method not found")
@@ -83,6 +156,15 @@ func (u *PaymentMethodUsecase) Update(ctx context.Context, id int64, in model.Up
 
 	paymentMethod.Name = in.Name
 	paymentMethod.BankCode = in.BankCode
+	paymentMethod.Type = in.Type
+	if in.Provider != "" {
+		paymentMethod.Provider = in.Provider
+	}
+	paymentMethod.Config = in.Config
+	if err := u.validateMethod(*paymentMethod); err != nil {
+		log.Error("Invalid payment method: ", err)
+		return err
+	}
 	paymentMethod.UpdatedAt = time.Now()
 
 	if err := u.paymentMethodRepo.Update(ctx, *paymentMethod); err != nil {
@@ -123,3 +205,31 @@ func (u *PaymentMethodUsecase) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// validateMethod checks the config against the method's type, and that the
+// type fits the provider: bank transfers need a virtual account provider,
+// whose account numbers must be prefixable by the method.
+func (u *PaymentMethodUsecase) validateMethod(paymentMethod model.PaymentMethod) error {
+	if err := paymentMethod.Config.ValidateFor(paymentMethod.Type); err != nil {
+		return err
+	}
+
+	provider, err := u.providers.Get(paymentMethod.Provider)
+	if err != nil {
+		return err
+	}
+
+	if !provider.Supports(paymentMethod.Type) {
+		return fmt.Errorf("%w: type %s cannot use provider %s", model.ErrInvalidPaymentMethodConfig, paymentMethod.Type, provider.Name())
+	}
+
+	vaProvider, ok := provider.(model.VirtualAccountProvider)
+	if !ok {
+		return nil
+	}
+	prefix := paymentMethod.VirtualAccountPrefix()
+	if _, err := helper.VirtualAccountNumber(prefix, 0, vaProvider.VirtualAccountLength()); err != nil {
+		return fmt.Errorf("%w: %q", model.ErrInvalidBankCode, prefix)
+	}
+	return nil
+}
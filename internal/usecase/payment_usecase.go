@@ -2,30 +2,61 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
 	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
 	pbOrder "github.com/tubagusmf/ecommerce-user-product-service/pb/order"
 	pbUser "github.com/tubagusmf/ecommerce-user-product-service/pb/user"
 )
 
 type PaymentUsecase struct {
-	paymentRepo model.IPaymentRepository
-	orderClient pbOrder.OrderServiceClient
-	userClient  pbUser.UserServiceClient
+	paymentRepo        model.IPaymentRepository
+	userSegmentRepo    model.IUserSegmentRepository
+	transactor         model.ITransactor
+	promotionUsecase   model.IPromotionUsecase
+	reservationUsecase model.IReservationUsecase
+	idempotencyRepo    model.IIdempotencyRepository
+	providers          model.IProviderRegistry
+	webhooks           model.IWebhookDispatcher
+	outboxRepo         model.IOutboxRepository
+	events             model.IEventPublisher
+	orderClient        pbOrder.OrderServiceClient
+	userClient         pbUser.UserServiceClient
 }
 
 func NewPaymentUsecase(
 	paymentRepo model.IPaymentRepository,
+	userSegmentRepo model.IUserSegmentRepository,
+	transactor model.ITransactor,
+	promotionUsecase model.IPromotionUsecase,
+	reservationUsecase model.IReservationUsecase,
+	idempotencyRepo model.IIdempotencyRepository,
+	providers model.IProviderRegistry,
+	webhooks model.IWebhookDispatcher,
+	outboxRepo model.IOutboxRepository,
+	events model.IEventPublisher,
 	orderClient pbOrder.OrderServiceClient,
 	userClient pbUser.UserServiceClient,
 ) model.IPaymentUsecase {
 	return &PaymentUsecase{
-		paymentRepo: paymentRepo,
-		orderClient: orderClient,
-		userClient:  userClient,
+		paymentRepo:        paymentRepo,
+		userSegmentRepo:    userSegmentRepo,
+		transactor:         transactor,
+		promotionUsecase:   promotionUsecase,
+		reservationUsecase: reservationUsecase,
+		idempotencyRepo:    idempotencyRepo,
+		providers:          providers,
+		webhooks:           webhooks,
+		outboxRepo:         outboxRepo,
+		events:             events,
+		orderClient:        orderClient,
+		userClient:         userClient,
 	}
 }
 
@@ -33,75 +64,688 @@ func (u *PaymentUsecase) GetPaymentMethodByID(ctx context.Context, methodID int6
 	paymentMethod, err := u.paymentRepo.FindPaymentMethodByID(ctx, methodID)
 	if err != nil {
 		log.Printf("[ERROR] Payment method not found: %v", err)
-		return nil, errors.New("payment method not found")
+		return nil, model.ErrPaymentMethodNotFound
 	}
 	return paymentMethod, nil
 }
 
-func (u *PaymentUsecase) ProcessPayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, paymentStatus model.PaymentStatus) (*model.Payment, error) {
+// ProcessPayment records a payment for an order and charges it through the
+// provider of the payment method. The breakdown subtotal must match the order
+// total reported by the order service; discounts, tax and shipping priced by
+// this service are applied on top of it.
+//
+// The payment is stored as pending before the provider is called and then
+// moved to whatever status the provider reports. A declined charge is
+// returned as a failed payment, not as an error.
+func (u *PaymentUsecase) ProcessPayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails) (*model.Payment, error) {
+	return u.processPayment(ctx, orderID, userID, paymentMethod, breakdown, details, nil)
+}
+
+// processPayment is ProcessPayment with a hook that runs in the transaction
+// that creates the payment row.
+func (u *PaymentUsecase) processPayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails, onCreated paymentCreatedHook) (*model.Payment, error) {
+	payment, provider, err := u.openPayment(ctx, orderID, userID, paymentMethod, breakdown, details, onCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Charge(ctx, providerRequest(payment, details))
+	if err != nil {
+		log.Printf("[ERROR] Provider %s failed to charge OrderID %s: %v", provider.Name(), orderID, err)
+		return nil, u.abortPayment(ctx, payment, err)
+	}
+
+	if err := u.settlePayment(ctx, payment, provider, result); err != nil {
+		log.Printf("[ERROR] Failed to apply provider result for OrderID %s: %v", orderID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Payment processed for OrderID: %s with status %s", orderID, payment.Status)
+	return payment, nil
+}
+
+// AuthorizePayment places a hold for the payment amount without taking the
+// money. The hold, and the stock reserved for the order, last until the
+// payment is captured or voided, or until the authorization expires. A
+// declined authorization is returned as a failed payment, not as an error.
+func (u *PaymentUsecase) AuthorizePayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails) (*model.Payment, error) {
+	return u.authorizePayment(ctx, orderID, userID, paymentMethod, breakdown, details, nil)
+}
+
+// authorizePayment is AuthorizePayment with a hook that runs in the
+// transaction that creates the payment row.
+func (u *PaymentUsecase) authorizePayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails, onCreated paymentCreatedHook) (*model.Payment, error) {
+	payment, provider, err := u.openPayment(ctx, orderID, userID, paymentMethod, breakdown, details, onCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Authorize(ctx, providerRequest(payment, details))
+	if err != nil {
+		log.Printf("[ERROR] Provider %s failed to authorize OrderID %s: %v", provider.Name(), orderID, err)
+		return nil, u.abortPayment(ctx, payment, err)
+	}
+
+	if err := u.settlePayment(ctx, payment, provider, result); err != nil {
+		log.Printf("[ERROR] Failed to apply provider result for OrderID %s: %v", orderID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Payment authorized for OrderID: %s with status %s", orderID, payment.Status)
+	return payment, nil
+}
+
+// CapturePayment takes the money held by an authorization, either in full or
+// in part. Whatever is not captured is given back to the payer.
+func (u *PaymentUsecase) CapturePayment(ctx context.Context, paymentID int64, in model.CapturePaymentInput) (*model.Payment, error) {
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Printf("[ERROR] Invalid capture request: %v", err)
+		return nil, err
+	}
+
+	payment, err := u.findPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status != model.StatusAuthorized {
+		return nil, &model.InvalidTransitionError{From: payment.Status, To: model.StatusCaptured}
+	}
+	if payment.AuthorizationExpiresAt != nil && !payment.AuthorizationExpiresAt.After(time.Now()) {
+		return nil, model.ErrAuthorizationExpired
+	}
+
+	amount := in.Amount
+	if amount == 0 {
+		amount = payment.AuthorizedAmount
+	}
+	if amount > payment.AuthorizedAmount {
+		return nil, model.ErrCaptureExceedsAuthorization
+	}
+
+	provider, err := u.providers.Get(payment.PaymentMethod.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.Capture(ctx, payment.TransactionID, amount)
+	if err != nil {
+		log.Printf("[ERROR] Provider %s failed to capture %s: %v", provider.Name(), payment.TransactionID, err)
+		return nil, providerError(err)
+	}
+	if result.Status != model.StatusCaptured {
+		log.Printf("[ERROR] Provider %s reported %s for capture of %s", provider.Name(), result.Status, payment.TransactionID)
+		return nil, fmt.Errorf("%w: capture ended as %s", model.ErrProviderUnavailable, result.Status)
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.paymentRepo.UpdateCapturedAmount(ctx, payment.ID, amount); err != nil {
+			return err
+		}
+		payment.CapturedAmount = amount
+		return u.markPaid(ctx, payment, model.StatusCaptured)
+	})
+	if err != nil {
+		log.Printf("[ERROR] Failed to record capture of payment %d: %v", payment.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Captured %d of %d for payment %d", amount, payment.AuthorizedAmount, payment.ID)
+	return payment, nil
+}
+
+// VoidPayment cancels an authorization before it is captured and releases
+// the stock held for the order.
+func (u *PaymentUsecase) VoidPayment(ctx context.Context, paymentID int64) (*model.Payment, error) {
+	payment, err := u.findPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.voidAuthorization(ctx, payment); err != nil {
+		log.Printf("[ERROR] Failed to void payment %d: %v", payment.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Voided payment %d", payment.ID)
+	return payment, nil
+}
+
+// VoidExpiredAuthorizations voids authorizations that were not captured in
+// time. Payments the provider cannot void right now are retried on the next
+// run.
+func (u *PaymentUsecase) VoidExpiredAuthorizations(ctx context.Context) (int64, error) {
+	payments, err := u.paymentRepo.FindExpiredAuthorizations(ctx, time.Now(), config.PaymentAuthorizationBatchSize())
+	if err != nil {
+		log.Printf("[ERROR] Failed to find expired authorizations: %v", err)
+		return 0, err
+	}
+
+	var voided int64
+	for _, payment := range payments {
+		if err := u.voidAuthorization(ctx, payment); err != nil {
+			log.Printf("[ERROR] Failed to void expired authorization of payment %d: %v", payment.ID, err)
+			continue
+		}
+		voided++
+	}
+	return voided, nil
+}
+
+func (u *PaymentUsecase) voidAuthorization(ctx context.Context, payment *model.Payment) error {
+	if payment.Status != model.StatusAuthorized {
+		return &model.InvalidTransitionError{From: payment.Status, To: model.StatusCancelled}
+	}
+	return u.void(ctx, payment, model.StatusCancelled)
+}
+
+// ExpirePendingPayments expires a batch of pending payments that were not
+// completed in time. The batch is claimed and committed before any provider
+// is called, so no row stays locked while a void is in flight and the
+// expirer can run on several replicas at once. A payment that cannot be
+// expired right now is tried again after a growing backoff instead of
+// holding up the rest of the backlog.
+func (u *PaymentUsecase) ExpirePendingPayments(ctx context.Context) (int64, error) {
+	now := time.Now()
+	payments, err := u.paymentRepo.ClaimExpiredPending(ctx, now, now.Add(config.PaymentPendingLease()), config.PaymentPendingBatchSize())
+	if err != nil {
+		log.Printf("[ERROR] Failed to expire pending payments: %v", err)
+		return 0, err
+	}
+
+	var expired int64
+	for _, payment := range payments {
+		if err := u.void(ctx, payment, model.StatusExpired); err != nil {
+			log.Printf("[ERROR] Failed to void expired payment %d: %v", payment.ID, err)
+
+			nextAttemptAt := time.Now().Add(helper.Backoff(payment.ExpiryAttempts, config.PaymentPendingBackoff(), config.PaymentPendingMaxBackoff()))
+			if err := u.paymentRepo.DeferExpiry(ctx, payment.ID, nextAttemptAt); err != nil {
+				log.Printf("[ERROR] Failed to defer expiry of payment %d: %v", payment.ID, err)
+			}
+			continue
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// pendingTTL is how long a payment made with the method may stay pending.
+func pendingTTL(paymentMethod model.PaymentMethod) time.Duration {
+	if paymentMethod.Config.ExpiryMinutes > 0 {
+		return time.Duration(paymentMethod.Config.ExpiryMinutes) * time.Minute
+	}
+	return config.PaymentPendingTTL()
+}
+
+// CancelPayment cancels a payment that has not settled yet, voiding it at
+// its provider. Pending and authorized payments can be cancelled.
+func (u *PaymentUsecase) CancelPayment(ctx context.Context, paymentID int64) (*model.Payment, error) {
+	payment, err := u.findPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status != model.StatusPending && payment.Status != model.StatusAuthorized {
+		return nil, &model.InvalidTransitionError{From: payment.Status, To: model.StatusCancelled}
+	}
+
+	if err := u.void(ctx, payment, model.StatusCancelled); err != nil {
+		log.Printf("[ERROR] Failed to cancel payment %d: %v", payment.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Cancelled payment %d", payment.ID)
+	return payment, nil
+}
+
+// void releases the payment at its provider, if it got that far, and marks
+// it cancelled or expired.
+func (u *PaymentUsecase) void(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	if payment.TransactionID != "" {
+		provider, err := u.providers.Get(payment.PaymentMethod.Provider)
+		if err != nil {
+			return err
+		}
+		if _, err := provider.Void(ctx, payment.TransactionID); err != nil {
+			return providerError(err)
+		}
+	}
+	return u.markFailed(ctx, payment, status)
+}
+
+// openPayment validates a payment request against the order and stores the
+// payment as pending. It returns the provider that should process it.
+func (u *PaymentUsecase) openPayment(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails, onCreated paymentCreatedHook) (*model.Payment, model.PaymentProvider, error) {
+	if err := helper.Validator.Struct(details); err != nil {
+		log.Printf("[ERROR] Invalid payment details: %v", err)
+		return nil, nil, err
+	}
+
+	provider, err := u.providers.Get(paymentMethod.Provider)
+	if err != nil {
+		log.Printf("[ERROR] No provider %q for payment method %d: %v", paymentMethod.Provider, paymentMethod.ID, err)
+		return nil, nil, err
+	}
+
 	// check Order
 	order, err := u.orderClient.GetOrder(ctx, &pbOrder.GetOrderRequest{OrderId: orderID})
-	if err != nil || order == nil {
+	if err != nil || order == nil || order.Order == nil {
 		log.Printf("[ERROR] Invalid order: %v", err)
-		return nil, errors.New("invalid order")
+		return nil, nil, errors.New("invalid order")
 	}
 
 	// check User
 	user, err := u.userClient.GetUser(ctx, &pbUser.GetUserRequest{UserId: userID})
 	if err != nil || user == nil {
 		log.Printf("[ERROR] Invalid user: %v", err)
-		return nil, errors.New("invalid user")
+		return nil, nil, errors.New("invalid user")
 	}
 
 	if paymentMethod.ID == 0 {
 		log.Printf("[ERROR] Invalid PaymentMethod ID: %d", paymentMethod.ID)
-		return nil, errors.New("invalid payment method ID")
+		return nil, nil, errors.New("invalid payment method ID")
 	}
 
+	if breakdown == nil {
+		log.Printf("[ERROR] Missing amount for OrderID: %s", orderID)
+		return nil, nil, model.ErrPaymentAmountMismatch
+	}
+
+	orderTotal := orderItemsTotal(order.Order)
+	if breakdown.Subtotal != orderTotal ||
+		breakdown.GrandTotal != breakdown.Subtotal-breakdown.DiscountTotal+breakdown.Tax+breakdown.Shipping+breakdown.Fee {
+		log.Printf("[ERROR] Amount mismatch for OrderID %s: subtotal %d, order total %d", orderID, breakdown.Subtotal, orderTotal)
+		return nil, nil, model.ErrPaymentAmountMismatch
+	}
+
+	// The same rules that decide which methods are listed as available.
+	var segments []string
+	if paymentMethod.Config.RestrictsUsers() {
+		segments, err = u.userSegmentRepo.FindSegmentsByUserID(ctx, userID)
+		if err != nil {
+			log.Printf("[ERROR] Failed to get segments of user %d: %v", userID, err)
+			return nil, nil, err
+		}
+	}
+	query := model.AvailabilityQuery{Amount: breakdown.GrandTotal, Currency: breakdown.Currency, UserID: userID}
+	if err := paymentMethod.Config.CheckAvailability(query, segments, config.PricingCurrency(), time.Now()); err != nil {
+		log.Printf("[ERROR] Payment method %d cannot be used for OrderID %s: %v", paymentMethod.ID, orderID, err)
+		return nil, nil, err
+	}
+
+	// The customer pays the method's fee on top of the availability amount.
+	breakdown = breakdown.WithFee(paymentMethod.Config.FeeFor(breakdown.GrandTotal - breakdown.Fee))
+
+	expiresAt := time.Now().Add(pendingTTL(paymentMethod))
 	payment := &model.Payment{
 		OrderID:         orderID,
 		UserID:          userID,
 		PaymentMethodID: paymentMethod.ID,
 		PaymentMethod:   paymentMethod,
-		Status:          paymentStatus,
+		Status:          model.StatusPending,
+		Amount:          breakdown.GrandTotal,
+		Currency:        breakdown.Currency,
+		SubtotalAmount:  breakdown.Subtotal,
+		DiscountAmount:  breakdown.DiscountTotal,
+		TaxAmount:       breakdown.Tax,
+		ShippingAmount:  breakdown.Shipping,
+		FeeAmount:       breakdown.Fee,
+		CouponCode:      breakdown.AppliedCoupon,
+		ExpiresAt:       &expiresAt,
 	}
 
-	err = u.paymentRepo.Create(ctx, payment)
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if vaProvider, ok := provider.(model.VirtualAccountProvider); ok {
+			virtualAccount, err := u.nextVirtualAccount(ctx, paymentMethod, vaProvider)
+			if err != nil {
+				return err
+			}
+			payment.VirtualAccount = virtualAccount
+		}
+		if err := u.paymentRepo.Create(ctx, payment); err != nil {
+			return err
+		}
+		// The coupon is taken before the provider is called, so a coupon
+		// used up in the meantime can no longer fail a charged payment.
+		if err := u.promotionUsecase.Redeem(ctx, payment); err != nil {
+			return err
+		}
+		if onCreated != nil {
+			if err := onCreated(ctx, payment); err != nil {
+				return err
+			}
+		}
+		return u.events.Publish(ctx, model.EventTypePaymentCreated, model.PaymentCreatedEvent{
+			PaymentID: payment.ID,
+			OrderID:   payment.OrderID,
+			UserID:    payment.UserID,
+			Amount:    payment.Amount,
+			Currency:  payment.Currency,
+			Status:    payment.Status,
+		})
+	})
 	if err != nil {
 		log.Printf("[ERROR] Failed to save payment: %v", err)
-		return nil, fmt.Errorf("failed to save payment: %w", err)
+		return nil, nil, fmt.Errorf("failed to save payment: %w", err)
 	}
+	return payment, provider, nil
+}
 
-	if paymentStatus == model.StatusSuccess {
-		_, err := u.orderClient.MarkOrderPaid(ctx, &pbOrder.MarkOrderPaidRequest{OrderId: orderID})
-		if err != nil {
-			log.Printf("[ERROR] Failed to mark order as paid: %v", err)
-			return nil, fmt.Errorf("failed to mark order as paid: %w", err)
+// paymentCreatedHook is called with the new payment inside the transaction
+// that creates it.
+type paymentCreatedHook func(ctx context.Context, payment *model.Payment) error
+
+// nextVirtualAccount generates a fresh account number for a payment with
+// the method: its prefix, the next sequence number and a Luhn check digit.
+func (u *PaymentUsecase) nextVirtualAccount(ctx context.Context, paymentMethod model.PaymentMethod, vaProvider model.VirtualAccountProvider) (string, error) {
+	sequence, err := u.paymentRepo.NextVirtualAccountSequence(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := paymentMethod.VirtualAccountPrefix()
+	virtualAccount, err := helper.VirtualAccountNumber(prefix, sequence, vaProvider.VirtualAccountLength())
+	if errors.Is(err, helper.ErrInvalidVirtualAccountPrefix) {
+		return "", fmt.Errorf("%w: %q", model.ErrInvalidBankCode, prefix)
+	}
+	return virtualAccount, err
+}
+
+func providerRequest(payment *model.Payment, details model.PaymentDetails) model.ProviderRequest {
+	return model.ProviderRequest{
+		PaymentID:      payment.ID,
+		OrderID:        payment.OrderID,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+		CardNumber:     details.CardNumber,
+		VirtualAccount: payment.VirtualAccount,
+	}
+}
+
+// providerError reports any failure to talk to a provider as
+// ErrProviderUnavailable.
+func providerError(err error) error {
+	if errors.Is(err, model.ErrProviderUnavailable) {
+		return err
+	}
+	return fmt.Errorf("%w: %v", model.ErrProviderUnavailable, err)
+}
+
+// abortPayment fails a payment whose provider call did not go through.
+func (u *PaymentUsecase) abortPayment(ctx context.Context, payment *model.Payment, err error) error {
+	if failErr := u.markFailed(ctx, payment, model.StatusFailed); failErr != nil {
+		log.Printf("[ERROR] Failed to mark payment %d as failed: %v", payment.ID, failErr)
+	}
+	return providerError(err)
+}
+
+// settlePayment stores the provider's reference together with the status the
+// provider reported, in one transaction. If that cannot be written, the
+// charge or hold is reversed at the provider with the reference still at
+// hand and the payment is failed, so the gateway never keeps money the
+// payment does not know about and the request is safe to retry.
+func (u *PaymentUsecase) settlePayment(ctx context.Context, payment *model.Payment, provider model.PaymentProvider, result *model.ProviderResult) error {
+	opened := *payment
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.recordReference(ctx, payment, result); err != nil {
+			return err
+		}
+		return u.applyProviderResult(ctx, payment, result)
+	})
+	if err == nil {
+		return nil
+	}
+	*payment = opened
+
+	if reverseErr := reverseAtProvider(ctx, provider, payment, result); reverseErr != nil {
+		log.Printf("[ERROR] Payment %d was not recorded and reversing %s at provider %s failed, reconcile it by hand: %v", payment.ID, result.Reference, provider.Name(), reverseErr)
+		return fmt.Errorf("%w: %v", model.ErrPaymentNotRecorded, err)
+	}
+	if failErr := u.markFailed(ctx, payment, model.StatusFailed); failErr != nil {
+		log.Printf("[ERROR] Failed to mark payment %d as failed: %v", payment.ID, failErr)
+	}
+	return fmt.Errorf("%w: %v", model.ErrPaymentNotRecorded, err)
+}
+
+// reverseAtProvider undoes a provider call whose result could not be stored:
+// holds and pending charges are voided, settled charges refunded in full.
+// Refund ID 0 is never used by a refund row, and keys the reversal so that
+// it is paid back at most once.
+func reverseAtProvider(ctx context.Context, provider model.PaymentProvider, payment *model.Payment, result *model.ProviderResult) error {
+	switch {
+	case result.Status.IsPaid():
+		_, err := provider.Refund(ctx, result.Reference, 0, payment.Amount)
+		return err
+	case result.Status == model.StatusPending, result.Status == model.StatusAuthorized:
+		_, err := provider.Void(ctx, result.Reference)
+		return err
+	}
+	return nil
+}
+
+func (u *PaymentUsecase) recordReference(ctx context.Context, payment *model.Payment, result *model.ProviderResult) error {
+	if err := u.paymentRepo.UpdateTransactionID(ctx, payment.ID, result.Reference); err != nil {
+		log.Printf("[ERROR] Failed to store provider reference %s: %v", result.Reference, err)
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	payment.TransactionID = result.Reference
+
+	if result.QRPayload != "" {
+		if err := u.paymentRepo.UpdateQRPayload(ctx, payment.ID, result.QRPayload); err != nil {
+			log.Printf("[ERROR] Failed to store QR payload for payment %d: %v", payment.ID, err)
+			return fmt.Errorf("failed to save payment: %w", err)
 		}
-		log.Printf("[INFO] Order %s marked as PAID", orderID)
+		payment.QRPayload = result.QRPayload
+	}
+	return nil
+}
+
+// ProcessPaymentIdempotent runs ProcessPayment at most once per idempotency
+// key. A replay with the same request returns the payment created by the
+// first call; reusing the key for a different request is rejected. Without a
+// key it behaves like ProcessPayment.
+func (u *PaymentUsecase) ProcessPaymentIdempotent(ctx context.Context, idempotencyKey string, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails) (*model.Payment, error) {
+	return u.runIdempotent(ctx, model.IdempotencyScopeProcessPayment, idempotencyKey, orderID, userID, paymentMethod, breakdown, details, u.processPayment)
+}
+
+// AuthorizePaymentIdempotent runs AuthorizePayment at most once per
+// idempotency key, the same way ProcessPaymentIdempotent does for charges.
+func (u *PaymentUsecase) AuthorizePaymentIdempotent(ctx context.Context, idempotencyKey string, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails) (*model.Payment, error) {
+	return u.runIdempotent(ctx, model.IdempotencyScopeAuthorizePayment, idempotencyKey, orderID, userID, paymentMethod, breakdown, details, u.authorizePayment)
+}
+
+// paymentOperation opens a payment and hands it to its provider, running
+// onCreated in the transaction that creates the payment row.
+type paymentOperation func(ctx context.Context, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails, onCreated paymentCreatedHook) (*model.Payment, error)
+
+func (u *PaymentUsecase) runIdempotent(ctx context.Context, scope, idempotencyKey string, orderID string, userID int64, paymentMethod model.PaymentMethod, breakdown *model.PriceBreakdown, details model.PaymentDetails, run paymentOperation) (*model.Payment, error) {
+	if idempotencyKey == "" {
+		return run(ctx, orderID, userID, paymentMethod, breakdown, details, nil)
+	}
+
+	requestHash, err := model.HashRequest(orderID, userID, paymentMethod.ID, breakdown, details)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := u.claimIdempotencyKey(ctx, scope, idempotencyKey, requestHash)
+	if err != nil {
+		log.Printf("[ERROR] Idempotency key %q rejected: %v", idempotencyKey, err)
+		return nil, err
+	}
+	if replay != nil {
+		log.Printf("[INFO] Replaying payment for idempotency key %q", idempotencyKey)
+		return replay, nil
 	}
 
-	log.Printf("[INFO] Payment processed successfully for OrderID: %s", orderID)
+	// The key is linked to the payment together with creating it, so a
+	// retry can never create a second one.
+	linkPayment := func(ctx context.Context, payment *model.Payment) error {
+		return u.idempotencyRepo.SavePaymentID(ctx, scope, idempotencyKey, payment.ID)
+	}
+	payment, err := run(ctx, orderID, userID, paymentMethod, breakdown, details, linkPayment)
+	if err != nil {
+		u.finishFailedIdempotentRequest(ctx, scope, idempotencyKey)
+		return nil, err
+	}
+
+	u.saveIdempotentResponse(ctx, scope, idempotencyKey, payment)
 	return payment, nil
 }
 
+// finishFailedIdempotentRequest frees the key of a request that failed before
+// its payment was created, so the client can retry once the cause is fixed.
+// When the payment was created the key keeps it and retries get it back.
+func (u *PaymentUsecase) finishFailedIdempotentRequest(ctx context.Context, scope, idempotencyKey string) {
+	key, err := u.idempotencyRepo.Find(ctx, scope, idempotencyKey)
+	if err != nil || key == nil {
+		// Without knowing whether a payment exists the key has to stay; it
+		// is taken over once the in-progress timeout has passed.
+		log.Printf("[ERROR] Failed to look up idempotency key %q: %v", idempotencyKey, err)
+		return
+	}
+
+	if key.PaymentID == nil {
+		if err := u.idempotencyRepo.Delete(ctx, scope, idempotencyKey); err != nil {
+			log.Printf("[ERROR] Failed to release idempotency key %q: %v", idempotencyKey, err)
+		}
+		return
+	}
+
+	payment, err := u.paymentRepo.FindById(ctx, *key.PaymentID)
+	if err != nil {
+		log.Printf("[ERROR] Failed to load payment %d for idempotency key %q: %v", *key.PaymentID, idempotencyKey, err)
+		return
+	}
+	u.saveIdempotentResponse(ctx, scope, idempotencyKey, payment)
+}
+
+func (u *PaymentUsecase) saveIdempotentResponse(ctx context.Context, scope, idempotencyKey string, payment *model.Payment) {
+	response, err := json.Marshal(payment)
+	if err == nil {
+		err = u.idempotencyRepo.SaveResponse(ctx, scope, idempotencyKey, response)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Failed to store response for idempotency key %q: %v", idempotencyKey, err)
+	}
+}
+
+// claimIdempotencyKey registers the key for this request. It returns the
+// stored payment when the key already completed with the same request.
+// Expired keys and keys left in progress past the timeout, e.g. by a crashed
+// instance, are taken over.
+func (u *PaymentUsecase) claimIdempotencyKey(ctx context.Context, scope, idempotencyKey, requestHash string) (*model.Payment, error) {
+	now := time.Now()
+	key := &model.IdempotencyKey{
+		Scope:       scope,
+		Key:         idempotencyKey,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(config.IdempotencyTTL()),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	created, err := u.idempotencyRepo.Create(ctx, key)
+	if err != nil || created {
+		return nil, err
+	}
+
+	existing, err := u.idempotencyRepo.Find(ctx, key.Scope, key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	timedOut := existing != nil && existing.Response == nil && existing.UpdatedAt.Before(now.Add(-config.IdempotencyInProgressTimeout()))
+	stale := existing == nil || existing.ExpiresAt.Before(now) || (timedOut && existing.PaymentID == nil)
+	if stale {
+		if existing != nil {
+			if err := u.idempotencyRepo.Delete(ctx, key.Scope, key.Key); err != nil {
+				return nil, err
+			}
+		}
+		created, err := u.idempotencyRepo.Create(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			return nil, model.ErrIdempotencyKeyInProgress
+		}
+		return nil, nil
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, model.ErrIdempotencyKeyMismatch
+	}
+
+	if existing.Response == nil {
+		// A request that died after creating its payment left no response;
+		// the payment as it stands now is replayed instead.
+		if timedOut {
+			return u.paymentRepo.FindById(ctx, *existing.PaymentID)
+		}
+		return nil, model.ErrIdempotencyKeyInProgress
+	}
+
+	var payment model.Payment
+	if err := json.Unmarshal(existing.Response, &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// DeleteExpiredIdempotencyKeys deletes keys past their TTL.
+func (u *PaymentUsecase) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	return u.idempotencyRepo.DeleteExpired(ctx, time.Now())
+}
+
+// ConfirmPayment asks the provider for the outcome of a pending payment and
+// settles the payment accordingly. It fails with ErrPaymentStillPending while
+// the provider has not decided yet.
 func (u *PaymentUsecase) ConfirmPayment(ctx context.Context, orderID string) error {
 	payment, err := u.paymentRepo.FindByOrderID(ctx, orderID)
 	if err != nil || payment == nil {
 		log.Printf("[ERROR] Payment not found for OrderID: %s", orderID)
-		return errors.New("payment not found")
+		return model.ErrPaymentNotFound
+	}
+
+	if payment.Status != model.StatusPending {
+		return &model.InvalidTransitionError{From: payment.Status, To: model.StatusSuccess}
+	}
+
+	provider, err := u.providers.Get(payment.PaymentMethod.Provider)
+	if err != nil {
+		log.Printf("[ERROR] No provider %q for payment %d: %v", payment.PaymentMethod.Provider, payment.ID, err)
+		return err
 	}
 
-	err = u.paymentRepo.UpdateStatus(ctx, orderID, model.StatusSuccess)
+	result, err := provider.Status(ctx, payment.TransactionID)
 	if err != nil {
+		log.Printf("[ERROR] Provider %s failed to report status of %s: %v", provider.Name(), payment.TransactionID, err)
+		return providerError(err)
+	}
+
+	if result.Status == model.StatusPending {
+		return model.ErrPaymentStillPending
+	}
+
+	if err := u.applyProviderResult(ctx, payment, result); err != nil {
 		log.Printf("[ERROR] Failed to update payment status: %v", err)
 		return err
 	}
 
-	log.Printf("[INFO] Payment confirmed for OrderID: %s", orderID)
+	log.Printf("[INFO] Payment confirmed for OrderID: %s with status %s", orderID, payment.Status)
 	return nil
 }
 
+func (u *PaymentUsecase) findPayment(ctx context.Context, paymentID int64) (*model.Payment, error) {
+	payment, err := u.paymentRepo.FindById(ctx, paymentID)
+	if err != nil || payment == nil {
+		log.Printf("[ERROR] Payment not found: %d: %v", paymentID, err)
+		return nil, model.ErrPaymentNotFound
+	}
+	return payment, nil
+}
+
 func (u *PaymentUsecase) GetPaymentStatus(ctx context.Context, paymentID int64) (*model.Payment, error) {
 	payment, err := u.paymentRepo.FindById(ctx, paymentID)
 	if err != nil {
@@ -138,11 +782,178 @@ func (u *PaymentUsecase) GetPaymentByOrderID(ctx context.Context, orderID string
 	return payment, nil
 }
 
-func (u *PaymentUsecase) MarkPaymentPaid(ctx context.Context, id string) error {
-	err := u.paymentRepo.UpdateStatus(ctx, id, model.StatusSuccess)
+// ApplyProviderUpdate applies a status pushed by a provider, e.g. through a
+// webhook, to the payment with the given provider reference. Updates that
+// repeat the current status are accepted without doing anything.
+func (u *PaymentUsecase) ApplyProviderUpdate(ctx context.Context, reference string, status model.PaymentStatus) (*model.Payment, error) {
+	payment, err := u.paymentRepo.FindByTransactionID(ctx, reference)
 	if err != nil {
-		log.Printf("[ERROR] Failed to mark payment as paid: %v", err)
-		return err
+		log.Printf("[ERROR] Failed to find payment for reference %s: %v", reference, err)
+		return nil, err
+	}
+	if payment == nil {
+		return nil, model.ErrPaymentNotFound
+	}
+
+	if payment.Status == status {
+		return payment, nil
+	}
+
+	result := &model.ProviderResult{Reference: reference, Status: status}
+	if err := u.applyProviderResult(ctx, payment, result); err != nil {
+		log.Printf("[ERROR] Failed to apply %s from provider to payment %d: %v", status, payment.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Payment %d moved to %s by provider update", payment.ID, payment.Status)
+	return payment, nil
+}
+
+// ApplyVirtualAccountTransfer settles a virtual account payment on an exact-amount transfer.
+func (u *PaymentUsecase) ApplyVirtualAccountTransfer(ctx context.Context, virtualAccount string, amount int64) (*model.Payment, error) {
+	payment, err := u.paymentRepo.FindByVirtualAccount(ctx, virtualAccount)
+	if err != nil {
+		log.Printf("[ERROR] Failed to find payment for virtual account %s: %v", virtualAccount, err)
+		return nil, err
+	}
+	if payment == nil {
+		return nil, model.ErrPaymentNotFound
+	}
+
+	if payment.Status.IsPaid() {
+		return payment, nil
+	}
+
+	if amount != payment.Amount {
+		log.Printf("[ERROR] Transfer of %d into virtual account %s does not match payment %d amount %d", amount, virtualAccount, payment.ID, payment.Amount)
+		return nil, model.ErrTransferAmountMismatch
+	}
+
+	if err := u.markPaid(ctx, payment, model.StatusSuccess); err != nil {
+		log.Printf("[ERROR] Failed to settle payment %d by transfer: %v", payment.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[INFO] Payment %d settled by transfer into virtual account %s", payment.ID, virtualAccount)
+	return payment, nil
+}
+
+// applyProviderResult moves the payment to the status reported by its
+// provider. Pending results leave the payment untouched.
+func (u *PaymentUsecase) applyProviderResult(ctx context.Context, payment *model.Payment, result *model.ProviderResult) error {
+	switch result.Status {
+	case model.StatusSuccess, model.StatusCaptured:
+		return u.markPaid(ctx, payment, result.Status)
+	case model.StatusAuthorized:
+		return u.markAuthorized(ctx, payment)
+	case model.StatusFailed, model.StatusCancelled, model.StatusExpired:
+		log.Printf("[INFO] Provider rejected payment %d: %s", payment.ID, result.Message)
+		return u.markFailed(ctx, payment, result.Status)
 	}
 	return nil
 }
+
+// markFailed moves the payment to a terminal unpaid status, gives back its
+// coupon, releases its stock reservations and queues the payment.failed
+// webhook atomically.
+func (u *PaymentUsecase) markFailed(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.transition(ctx, payment, status); err != nil {
+			return err
+		}
+		if err := u.promotionUsecase.Release(ctx, payment); err != nil {
+			return err
+		}
+		if err := u.reservationUsecase.Release(ctx, payment.OrderID); err != nil {
+			return err
+		}
+		return u.webhooks.Dispatch(ctx, model.EventPaymentFailed, payment)
+	})
+}
+
+// markAuthorized records the authorization hold and keeps the order's stock
+// reserved until the hold expires.
+func (u *PaymentUsecase) markAuthorized(ctx context.Context, payment *model.Payment) error {
+	expiresAt := time.Now().Add(config.PaymentAuthorizationTTL())
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.transition(ctx, payment, model.StatusAuthorized); err != nil {
+			return err
+		}
+		if err := u.paymentRepo.UpdateAuthorization(ctx, payment.ID, payment.Amount, expiresAt); err != nil {
+			return err
+		}
+		payment.AuthorizedAmount = payment.Amount
+		payment.AuthorizationExpiresAt = &expiresAt
+		return u.reservationUsecase.Extend(ctx, payment.OrderID, expiresAt)
+	})
+}
+
+// markPaid moves the payment to a paid status, commits its stock
+// reservations and queues the payment.succeeded webhook and the order
+// service notification atomically. Its coupon was already redeemed when the
+// payment was created.
+func (u *PaymentUsecase) markPaid(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.transition(ctx, payment, status); err != nil {
+			return err
+		}
+		if err := u.reservationUsecase.Commit(ctx, payment.OrderID); err != nil {
+			return err
+		}
+		if err := u.webhooks.Dispatch(ctx, model.EventPaymentSucceeded, payment); err != nil {
+			return err
+		}
+		message, err := model.NewOutboxMessage(model.OutboxTopicOrderPaid, payment.OrderID, model.OrderPaidMessage{
+			OrderID:   payment.OrderID,
+			PaymentID: payment.ID,
+		})
+		if err != nil {
+			return err
+		}
+		return u.outboxRepo.Create(ctx, message)
+	})
+}
+
+// transition moves the payment to the given status if the state machine
+// allows it and publishes the change. The update is conditional on the
+// status the payment was read with, so a concurrent change surfaces as an
+// invalid transition from the status it was changed to. Callers run it
+// inside a transaction.
+func (u *PaymentUsecase) transition(ctx context.Context, payment *model.Payment, to model.PaymentStatus) error {
+	if err := model.ValidatePaymentTransition(payment.Status, to); err != nil {
+		return err
+	}
+
+	updated, err := u.paymentRepo.UpdateStatus(ctx, payment.ID, payment.Status, to)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		current, err := u.paymentRepo.FindById(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		return &model.InvalidTransitionError{From: current.Status, To: to}
+	}
+
+	from := payment.Status
+	payment.Status = to
+	return u.events.Publish(ctx, model.EventTypePaymentStatusChanged, model.PaymentStatusChangedEvent{
+		PaymentID: payment.ID,
+		OrderID:   payment.OrderID,
+		From:      from,
+		To:        to,
+	})
+}
+
+// orderItemsTotal adds up the order in minor units the same way the cart is
+// priced, converting each unit price before multiplying, so the float total
+// of the order service never has to match to the cent.
+func orderItemsTotal(order *pbOrder.Order) int64 {
+	var total int64
+	for _, item := range order.Items {
+		total += helper.ToMinorUnits(item.Price) * item.Quantity
+	}
+	return total
+}
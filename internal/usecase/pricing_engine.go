@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type PricingEngine struct {
+	currency string
+	rules    []model.PricingRule
+}
+
+func NewPricingEngine(currency string, rules ...model.PricingRule) model.IPricingEngine {
+	return &PricingEngine{
+		currency: currency,
+		rules:    rules,
+	}
+}
+
+func (e *PricingEngine) Price(ctx context.Context, cart *model.Cart) (*model.PriceBreakdown, error) {
+	breakdown := &model.PriceBreakdown{
+		Currency: e.currency,
+		Lines:    make([]model.PriceLine, 0, len(cart.Items)),
+	}
+
+	for _, item := range cart.Items {
+		breakdown.Lines = append(breakdown.Lines, model.PriceLine{
+			ItemID:    item.ID,
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			UnitPrice: item.UnitPrice,
+			Quantity:  item.Quantity,
+			Subtotal:  item.UnitPrice * item.Quantity,
+		})
+	}
+	breakdown.Recalculate()
+
+	for _, rule := range e.rules {
+		if err := rule.Apply(ctx, cart, breakdown); err != nil {
+			return nil, fmt.Errorf("pricing rule %s: %w", rule.Name(), err)
+		}
+		breakdown.Recalculate()
+	}
+
+	return breakdown, nil
+}
+
+// ShippingRule charges a flat fee, waived once the discounted goods amount
+// reaches freeThreshold. A zero threshold never waives the fee.
+type ShippingRule struct {
+	flatFee       int64
+	freeThreshold int64
+}
+
+func NewShippingRule(flatFee, freeThreshold int64) model.PricingRule {
+	return &ShippingRule{
+		flatFee:       flatFee,
+		freeThreshold: freeThreshold,
+	}
+}
+
+func (r *ShippingRule) Name() string {
+	return "shipping"
+}
+
+func (r *ShippingRule) Apply(ctx context.Context, cart *model.Cart, breakdown *model.PriceBreakdown) error {
+	if len(breakdown.Lines) == 0 {
+		breakdown.Shipping = 0
+		return nil
+	}
+
+	if r.freeThreshold > 0 && breakdown.Taxable() >= r.freeThreshold {
+		breakdown.Shipping = 0
+		return nil
+	}
+
+	breakdown.Shipping = r.flatFee
+	return nil
+}
+
+// CouponRule applies the coupon attached to the cart. It runs after shipping
+// so free shipping coupons can waive the fee, and before tax so discounts
+// reduce the taxable amount. A coupon that stopped being usable is dropped
+// from the price rather than failing the whole cart.
+type CouponRule struct {
+	promotionUsecase model.IPromotionUsecase
+}
+
+func NewCouponRule(promotionUsecase model.IPromotionUsecase) model.PricingRule {
+	return &CouponRule{promotionUsecase: promotionUsecase}
+}
+
+func (r *CouponRule) Name() string {
+	return "coupon"
+}
+
+func (r *CouponRule) Apply(ctx context.Context, cart *model.Cart, breakdown *model.PriceBreakdown) error {
+	if cart.CouponCode == "" || len(breakdown.Lines) == 0 {
+		return nil
+	}
+
+	coupon, err := r.promotionUsecase.ValidateCoupon(ctx, cart.CouponCode, cart.UserID, breakdown.Subtotal)
+	if err != nil {
+		if isCouponRejection(err) {
+			logrus.WithField("cartID", cart.ID).Info("Skipping coupon ", cart.CouponCode, ": ", err)
+			return nil
+		}
+		return err
+	}
+
+	if coupon.Type == model.CouponTypeFreeShipping {
+		breakdown.Shipping = 0
+	} else {
+		breakdown.Discounts = append(breakdown.Discounts, model.PriceAdjustment{
+			Code:        coupon.Code,
+			Description: string(coupon.Type),
+			Amount:      coupon.Discount(breakdown.Taxable()),
+		})
+	}
+	breakdown.AppliedCoupon = coupon.Code
+
+	return nil
+}
+
+func isCouponRejection(err error) bool {
+	return errors.Is(err, model.ErrCouponNotFound) ||
+		errors.Is(err, model.ErrCouponInactive) ||
+		errors.Is(err, model.ErrCouponMinCartValue) ||
+		errors.Is(err, model.ErrCouponUsageExceeded) ||
+		errors.Is(err, model.ErrCouponUserLimitReached)
+}
+
+// TaxRule applies a rate expressed in basis points to the discounted goods
+// amount, rounding half up.
+type TaxRule struct {
+	rateBps int64
+}
+
+func NewTaxRule(rateBps int64) model.PricingRule {
+	return &TaxRule{rateBps: rateBps}
+}
+
+func (r *TaxRule) Name() string {
+	return "tax"
+}
+
+func (r *TaxRule) Apply(ctx context.Context, cart *model.Cart, breakdown *model.PriceBreakdown) error {
+	breakdown.Tax = (breakdown.Taxable()*r.rateBps + 5000) / 10000
+	return nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type fakeCouponValidator struct {
+	model.IPromotionUsecase
+	coupon *model.Coupon
+	err    error
+}
+
+func (f *fakeCouponValidator) ValidateCoupon(ctx context.Context, code string, userID int64, subtotal int64) (*model.Coupon, error) {
+	return f.coupon, f.err
+}
+
+// extraDiscountRule stands in for another rule that discounts after the coupon.
+type extraDiscountRule struct {
+	amount int64
+}
+
+func (r extraDiscountRule) Name() string { return "extra" }
+
+func (r extraDiscountRule) Apply(ctx context.Context, cart *model.Cart, breakdown *model.PriceBreakdown) error {
+	breakdown.Discounts = append(breakdown.Discounts, model.PriceAdjustment{Code: "EXTRA", Amount: r.amount})
+	return nil
+}
+
+func TestPricingEngine(t *testing.T) {
+	const (
+		shippingFee   = 1000
+		freeThreshold = 50000
+	)
+
+	tests := []struct {
+		name   string
+		items  []model.CartItem
+		coupon *model.Coupon
+		taxBps int64
+		extra  model.PricingRule
+		want   model.PriceBreakdown
+	}{
+		{
+			name:   "coupon is taken off before tax",
+			items:  []model.CartItem{{UnitPrice: 5000, Quantity: 2}},
+			coupon: &model.Coupon{Code: "TWOK", Type: model.CouponTypeFixedAmount, Value: 2000},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 10000, DiscountTotal: 2000, Tax: 800, Shipping: shippingFee, GrandTotal: 9800},
+		},
+		{
+			name:   "percentage coupon rounds down and tax rounds half up",
+			items:  []model.CartItem{{UnitPrice: 999, Quantity: 1}},
+			coupon: &model.Coupon{Code: "P15", Type: model.CouponTypePercentage, Value: 15},
+			taxBps: 1100,
+			// 15% of 999 is 149.85, tax on 850 at 11% is 93.5.
+			want: model.PriceBreakdown{Subtotal: 999, DiscountTotal: 149, Tax: 94, Shipping: shippingFee, GrandTotal: 1944},
+		},
+		{
+			name:   "percentage coupon is capped at its maximum",
+			items:  []model.CartItem{{UnitPrice: 20000, Quantity: 1}},
+			coupon: &model.Coupon{Code: "P50", Type: model.CouponTypePercentage, Value: 50, MaxDiscount: 3000},
+			want:   model.PriceBreakdown{Subtotal: 20000, DiscountTotal: 3000, Shipping: shippingFee, GrandTotal: 18000},
+		},
+		{
+			name:   "tax rounds half up",
+			items:  []model.CartItem{{UnitPrice: 1045, Quantity: 1}},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 1045, Tax: 105, Shipping: shippingFee, GrandTotal: 2150},
+		},
+		{
+			name:  "shipping is charged below the threshold",
+			items: []model.CartItem{{UnitPrice: freeThreshold - 1, Quantity: 1}},
+			want:  model.PriceBreakdown{Subtotal: freeThreshold - 1, Shipping: shippingFee, GrandTotal: freeThreshold - 1 + shippingFee},
+		},
+		{
+			name:  "shipping is waived at the threshold",
+			items: []model.CartItem{{UnitPrice: freeThreshold / 2, Quantity: 2}},
+			want:  model.PriceBreakdown{Subtotal: freeThreshold, GrandTotal: freeThreshold},
+		},
+		{
+			name:   "threshold is checked before the coupon",
+			items:  []model.CartItem{{UnitPrice: freeThreshold, Quantity: 1}},
+			coupon: &model.Coupon{Code: "FIVEK", Type: model.CouponTypeFixedAmount, Value: 5000},
+			want:   model.PriceBreakdown{Subtotal: freeThreshold, DiscountTotal: 5000, GrandTotal: freeThreshold - 5000},
+		},
+		{
+			name:   "free shipping coupon waives the fee",
+			items:  []model.CartItem{{UnitPrice: 3000, Quantity: 1}},
+			coupon: &model.Coupon{Code: "SHIPFREE", Type: model.CouponTypeFreeShipping},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 3000, Tax: 300, GrandTotal: 3300},
+		},
+		{
+			name:   "coupon larger than the subtotal is clamped",
+			items:  []model.CartItem{{UnitPrice: 1500, Quantity: 2}},
+			coupon: &model.Coupon{Code: "BIG", Type: model.CouponTypeFixedAmount, Value: 10000},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 3000, DiscountTotal: 3000, Shipping: shippingFee, GrandTotal: shippingFee},
+		},
+		{
+			name:   "stacked discounts never exceed the subtotal",
+			items:  []model.CartItem{{UnitPrice: 4000, Quantity: 1}},
+			coupon: &model.Coupon{Code: "THREEK", Type: model.CouponTypeFixedAmount, Value: 3000},
+			extra:  extraDiscountRule{amount: 2500},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 4000, DiscountTotal: 4000, Shipping: shippingFee, GrandTotal: shippingFee},
+		},
+		{
+			name:   "coupon that was rejected is dropped",
+			items:  []model.CartItem{{UnitPrice: 4000, Quantity: 1}},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{Subtotal: 4000, Tax: 400, Shipping: shippingFee, GrandTotal: 5400},
+		},
+		{
+			name:   "empty cart costs nothing",
+			coupon: &model.Coupon{Code: "TWOK", Type: model.CouponTypeFixedAmount, Value: 2000},
+			taxBps: 1000,
+			want:   model.PriceBreakdown{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promotions := &fakeCouponValidator{coupon: tt.coupon}
+			cart := &model.Cart{ID: 1, UserID: 1, Items: tt.items}
+			if tt.coupon != nil {
+				cart.CouponCode = tt.coupon.Code
+			} else {
+				cart.CouponCode = "EXPIRED"
+				promotions.err = model.ErrCouponInactive
+			}
+
+			rules := []model.PricingRule{
+				NewShippingRule(shippingFee, freeThreshold),
+				NewCouponRule(promotions),
+			}
+			if tt.extra != nil {
+				rules = append(rules, tt.extra)
+			}
+			rules = append(rules, NewTaxRule(tt.taxBps))
+
+			got, err := NewPricingEngine("IDR", rules...).Price(context.Background(), cart)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Currency != "IDR" {
+				t.Errorf("currency = %q, want IDR", got.Currency)
+			}
+			if got.Subtotal != tt.want.Subtotal || got.DiscountTotal != tt.want.DiscountTotal ||
+				got.Tax != tt.want.Tax || got.Shipping != tt.want.Shipping || got.GrandTotal != tt.want.GrandTotal {
+				t.Errorf("subtotal %d, discount %d, tax %d, shipping %d, grand total %d; want %d, %d, %d, %d, %d",
+					got.Subtotal, got.DiscountTotal, got.Tax, got.Shipping, got.GrandTotal,
+					tt.want.Subtotal, tt.want.DiscountTotal, tt.want.Tax, tt.want.Shipping, tt.want.GrandTotal)
+			}
+			if got.GrandTotal != got.Taxable()+got.Tax+got.Shipping+got.Fee {
+				t.Errorf("grand total %d does not add up", got.GrandTotal)
+			}
+		})
+	}
+}
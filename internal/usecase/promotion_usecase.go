@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type PromotionUsecase struct {
+	couponRepo model.ICouponRepository
+	transactor model.ITransactor
+}
+
+func NewPromotionUsecase(couponRepo model.ICouponRepository, transactor model.ITransactor) model.IPromotionUsecase {
+	return &PromotionUsecase{
+		couponRepo: couponRepo,
+		transactor: transactor,
+	}
+}
+
+func (u *PromotionUsecase) FindAll(ctx context.Context, coupon model.Coupon) ([]*model.Coupon, error) {
+	coupons, err := u.couponRepo.FindAll(ctx, coupon)
+	if err != nil {
+		logrus.Error("Failed to get coupons: ", err)
+		return nil, err
+	}
+
+	return coupons, nil
+}
+
+func (u *PromotionUsecase) FindByID(ctx context.Context, id int64) (*model.Coupon, error) {
+	coupon, err := u.couponRepo.FindByID(ctx, id)
+	if err != nil {
+		logrus.WithField("id", id).Error("Failed to get coupon: ", err)
+		return nil, err
+	}
+
+	if coupon == nil {
+		return nil, model.ErrCouponNotFound
+	}
+
+	return coupon, nil
+}
+
+func (u *PromotionUsecase) Create(ctx context.Context, in model.CreateCoupon) error {
+	log := logrus.WithFields(logrus.Fields{
+		"in": in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return err
+	}
+
+	if err := validateCoupon(in.Type, in.Value, in.StartsAt, in.EndsAt); err != nil {
+		log.Error("Validation error: ", err)
+		return err
+	}
+
+	coupon := &model.Coupon{
+		Code:         strings.ToUpper(in.Code),
+		Type:         in.Type,
+		Value:        in.Value,
+		MaxDiscount:  in.MaxDiscount,
+		MinCartValue: in.MinCartValue,
+		UsageLimit:   in.UsageLimit,
+		PerUserLimit: in.PerUserLimit,
+		StartsAt:     in.StartsAt,
+		EndsAt:       in.EndsAt,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := u.couponRepo.Create(ctx, coupon); err != nil {
+		log.Error("Failed to create coupon: ", err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *PromotionUsecase) Update(ctx context.Context, id int64, in model.UpdateCoupon) error {
+	log := logrus.WithFields(logrus.Fields{
+		"id": id,
+		"in": in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return err
+	}
+
+	if err := validateCoupon(in.Type, in.Value, in.StartsAt, in.EndsAt); err != nil {
+		log.Error("Validation error: ", err)
+		return err
+	}
+
+	coupon, err := u.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	coupon.Code = strings.ToUpper(in.Code)
+	coupon.Type = in.Type
+	coupon.Value = in.Value
+	coupon.MaxDiscount = in.MaxDiscount
+	coupon.MinCartValue = in.MinCartValue
+	coupon.UsageLimit = in.UsageLimit
+	coupon.PerUserLimit = in.PerUserLimit
+	coupon.StartsAt = in.StartsAt
+	coupon.EndsAt = in.EndsAt
+	coupon.UpdatedAt = time.Now()
+
+	if err := u.couponRepo.Update(ctx, *coupon); err != nil {
+		log.Error("Failed to update coupon: ", err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *PromotionUsecase) Delete(ctx context.Context, id int64) error {
+	if _, err := u.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.couponRepo.Delete(ctx, id); err != nil {
+		logrus.WithField("id", id).Error("Failed to delete coupon: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// ValidateCoupon checks that code can be applied to a cart worth subtotal.
+// Per-user limits are only checked when userID is known.
+func (u *PromotionUsecase) ValidateCoupon(ctx context.Context, code string, userID int64, subtotal int64) (*model.Coupon, error) {
+	coupon, err := u.couponRepo.FindByCode(ctx, code)
+	if err != nil {
+		logrus.WithField("code", code).Error("Failed to get coupon: ", err)
+		return nil, err
+	}
+
+	if coupon == nil {
+		return nil, model.ErrCouponNotFound
+	}
+
+	if err := u.checkUsable(ctx, coupon, userID, subtotal); err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// Redeem records the coupon used by payment and consumes one use of it. The
+// coupon row is locked for the duration of the surrounding transaction, so
+// callers should run it in the same transaction that creates the payment,
+// before any money is taken.
+func (u *PromotionUsecase) Redeem(ctx context.Context, payment *model.Payment) error {
+	if payment.CouponCode == "" {
+		return nil
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		"paymentID": payment.ID,
+		"code":      payment.CouponCode,
+	})
+
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := u.couponRepo.FindRedemptionByPaymentID(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return nil
+		}
+
+		coupon, err := u.couponRepo.LockByCode(ctx, payment.CouponCode)
+		if err != nil {
+			return err
+		}
+		if coupon == nil {
+			return model.ErrCouponNotFound
+		}
+
+		if err := u.checkUsable(ctx, coupon, payment.UserID, payment.SubtotalAmount); err != nil {
+			log.Error("Coupon can no longer be redeemed: ", err)
+			return err
+		}
+
+		if err := u.couponRepo.CreateRedemption(ctx, &model.CouponRedemption{
+			CouponID:       coupon.ID,
+			UserID:         payment.UserID,
+			PaymentID:      payment.ID,
+			OrderID:        payment.OrderID,
+			DiscountAmount: payment.DiscountAmount,
+			CreatedAt:      time.Now(),
+		}); err != nil {
+			return err
+		}
+
+		return u.couponRepo.IncrementUsage(ctx, coupon.ID)
+	})
+}
+
+// Release gives back the coupon use redeemed by a payment that was never
+// paid. It does nothing when the payment redeemed no coupon.
+func (u *PromotionUsecase) Release(ctx context.Context, payment *model.Payment) error {
+	if payment.CouponCode == "" {
+		return nil
+	}
+
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		redemption, err := u.couponRepo.FindRedemptionByPaymentID(ctx, payment.ID)
+		if err != nil || redemption == nil {
+			return err
+		}
+
+		if err := u.couponRepo.DeleteRedemption(ctx, redemption.ID); err != nil {
+			return err
+		}
+		return u.couponRepo.DecrementUsage(ctx, redemption.CouponID)
+	})
+}
+
+func (u *PromotionUsecase) checkUsable(ctx context.Context, coupon *model.Coupon, userID int64, subtotal int64) error {
+	if !coupon.IsActive(time.Now()) {
+		if coupon.UsageLimit > 0 && coupon.UsedCount >= coupon.UsageLimit {
+			return model.ErrCouponUsageExceeded
+		}
+		return model.ErrCouponInactive
+	}
+
+	if subtotal < coupon.MinCartValue {
+		return model.ErrCouponMinCartValue
+	}
+
+	if coupon.PerUserLimit > 0 && userID != 0 {
+		used, err := u.couponRepo.CountRedemptionsByUser(ctx, coupon.ID, userID)
+		if err != nil {
+			return err
+		}
+		if used >= coupon.PerUserLimit {
+			return model.ErrCouponUserLimitReached
+		}
+	}
+
+	return nil
+}
+
+func validateCoupon(couponType model.CouponType, value int64, startsAt, endsAt *time.Time) error {
+	if couponType == model.CouponTypePercentage && (value <= 0 || value > 100) {
+		return fmt.Errorf("%w: percentage value must be between 1 and 100", model.ErrInvalidCoupon)
+	}
+
+	if couponType == model.CouponTypeFixedAmount && value <= 0 {
+		return fmt.Errorf("%w: fixed amount value must be greater than zero", model.ErrInvalidCoupon)
+	}
+
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return fmt.Errorf("%w: coupon must end after it starts", model.ErrInvalidCoupon)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type RefundUsecase struct {
+	refundRepo  model.IRefundRepository
+	paymentRepo model.IPaymentRepository
+	transactor  model.ITransactor
+	providers   model.IProviderRegistry
+	webhooks    model.IWebhookDispatcher
+	events      model.IEventPublisher
+}
+
+func NewRefundUsecase(
+	refundRepo model.IRefundRepository,
+	paymentRepo model.IPaymentRepository,
+	transactor model.ITransactor,
+	providers model.IProviderRegistry,
+	webhooks model.IWebhookDispatcher,
+	events model.IEventPublisher,
+) model.IRefundUsecase {
+	return &RefundUsecase{
+		refundRepo:  refundRepo,
+		paymentRepo: paymentRepo,
+		transactor:  transactor,
+		providers:   providers,
+		webhooks:    webhooks,
+		events:      events,
+	}
+}
+
+// Refund returns money for a settled payment through its provider. The refund
+// is first recorded as pending while the payment row is locked, so concurrent
+// refunds can never add up to more than was paid. The provider is only called
+// once that is committed, keyed by the refund ID, so a refund whose outcome is
+// unknown can be asked for again without paying the customer twice. A refund
+// the provider could not be reached for is returned still pending and is
+// finished by RetryPendingRefunds. The order service learns about the refund
+// from the refund.issued event.
+func (u *RefundUsecase) Refund(ctx context.Context, paymentID int64, in model.CreateRefund) (*model.RefundResult, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"paymentID": paymentID,
+		"in":        in,
+	})
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	var refund *model.Refund
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		payment, err := u.paymentRepo.LockByID(ctx, paymentID)
+		if err != nil {
+			return err
+		}
+		if payment == nil {
+			return model.ErrPaymentNotFound
+		}
+
+		if !payment.Status.CanTransitionTo(model.StatusRefunded) {
+			return model.ErrPaymentNotRefundable
+		}
+
+		committed, err := u.refundRepo.SumByPaymentID(ctx, payment.ID, model.RefundStatusPending, model.RefundStatusSucceeded)
+		if err != nil {
+			return err
+		}
+
+		remaining := payment.SettledAmount() - committed
+		amount := in.Amount
+		if amount == 0 {
+			amount = remaining
+		}
+		if amount <= 0 || amount > remaining {
+			return model.ErrRefundExceedsBalance
+		}
+
+		refund = &model.Refund{
+			PaymentID: payment.ID,
+			Amount:    amount,
+			Currency:  payment.Currency,
+			Reason:    in.Reason,
+			Status:    model.RefundStatusPending,
+		}
+		return u.refundRepo.Create(ctx, refund)
+	})
+	if err != nil {
+		log.Error("Failed to refund payment: ", err)
+		return nil, err
+	}
+
+	result, err := u.complete(ctx, refund)
+	if err != nil {
+		log.Error("Failed to refund payment: ", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// RetryPendingRefunds asks the provider again for refunds that were left
+// pending, e.g. because it could not be reached, and reports how many went
+// through.
+func (u *RefundUsecase) RetryPendingRefunds(ctx context.Context) (int64, error) {
+	refunds, err := u.refundRepo.FindPending(ctx, time.Now().Add(-config.RefundRetryAfter()), config.RefundRetryBatchSize())
+	if err != nil {
+		logrus.Error("Failed to find pending refunds: ", err)
+		return 0, err
+	}
+
+	var completed int64
+	for _, refund := range refunds {
+		log := logrus.WithField("refundID", refund.ID)
+
+		result, err := u.complete(ctx, refund)
+		if err != nil {
+			log.Error("Failed to retry refund: ", err)
+			continue
+		}
+		if result.Refund.Status == model.RefundStatusSucceeded {
+			completed++
+		}
+	}
+	return completed, nil
+}
+
+// complete asks the provider for a pending refund and records the outcome.
+func (u *RefundUsecase) complete(ctx context.Context, refund *model.Refund) (*model.RefundResult, error) {
+	payment, err := u.paymentRepo.FindById(ctx, refund.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := u.providers.Get(payment.PaymentMethod.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	providerResult, err := provider.Refund(ctx, payment.TransactionID, refund.ID, refund.Amount)
+	if errors.Is(err, model.ErrProviderUnavailable) {
+		// The money may or may not have gone out, so the refund stays
+		// pending until the provider can be asked again.
+		logrus.WithField("refundID", refund.ID).Warn("Refund left pending: ", err)
+		return u.result(ctx, refund, payment)
+	}
+	if err != nil {
+		// The provider turned the refund down, which frees its amount again.
+		if updateErr := u.refundRepo.UpdateResult(ctx, refund.ID, model.RefundStatusFailed, ""); updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, fmt.Errorf("%w: %v", model.ErrProviderUnavailable, err)
+	}
+
+	var result *model.RefundResult
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Both rows exist: the refund was committed before the provider call.
+		payment, err := u.paymentRepo.LockByID(ctx, refund.PaymentID)
+		if err != nil {
+			return err
+		}
+		refund, err := u.refundRepo.LockByID(ctx, refund.ID)
+		if err != nil {
+			return err
+		}
+
+		// Someone else already recorded the outcome.
+		if refund.Status != model.RefundStatusPending {
+			result, err = u.result(ctx, refund, payment)
+			return err
+		}
+
+		if err := u.refundRepo.UpdateResult(ctx, refund.ID, model.RefundStatusSucceeded, providerResult.Reference); err != nil {
+			return err
+		}
+		refund.Status = model.RefundStatusSucceeded
+		refund.Reference = providerResult.Reference
+
+		refunded, err := u.refundRepo.SumByPaymentID(ctx, payment.ID, model.RefundStatusSucceeded)
+		if err != nil {
+			return err
+		}
+		status := model.StatusPartiallyRefunded
+		if refunded >= payment.SettledAmount() {
+			status = model.StatusRefunded
+		}
+		if err := model.ValidatePaymentTransition(payment.Status, status); err != nil {
+			return err
+		}
+		if _, err := u.paymentRepo.UpdateStatus(ctx, payment.ID, payment.Status, status); err != nil {
+			return err
+		}
+		err = u.events.Publish(ctx, model.EventTypePaymentStatusChanged, model.PaymentStatusChangedEvent{
+			PaymentID: payment.ID,
+			OrderID:   payment.OrderID,
+			From:      payment.Status,
+			To:        status,
+		})
+		if err != nil {
+			return err
+		}
+		payment.Status = status
+
+		if err := u.webhooks.Dispatch(ctx, model.EventRefundCreated, refund); err != nil {
+			return err
+		}
+		err = u.events.Publish(ctx, model.EventTypeRefundIssued, model.RefundIssuedEvent{
+			RefundID:      refund.ID,
+			PaymentID:     payment.ID,
+			OrderID:       payment.OrderID,
+			Amount:        refund.Amount,
+			Currency:      refund.Currency,
+			Reason:        refund.Reason,
+			FullyRefunded: status == model.StatusRefunded,
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err = u.result(ctx, refund, payment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (u *RefundUsecase) result(ctx context.Context, refund *model.Refund, payment *model.Payment) (*model.RefundResult, error) {
+	refunded, err := u.refundRepo.SumByPaymentID(ctx, payment.ID, model.RefundStatusSucceeded)
+	if err != nil {
+		return nil, err
+	}
+	committed, err := u.refundRepo.SumByPaymentID(ctx, payment.ID, model.RefundStatusPending, model.RefundStatusSucceeded)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RefundResult{
+		Refund:          refund,
+		Payment:         payment,
+		RefundedAmount:  refunded,
+		RemainingAmount: payment.SettledAmount() - committed,
+	}, nil
+}
+
+func (u *RefundUsecase) FindByPaymentID(ctx context.Context, paymentID int64) ([]*model.Refund, error) {
+	payment, err := u.paymentRepo.FindById(ctx, paymentID)
+	if err != nil || payment == nil {
+		logrus.WithField("paymentID", paymentID).Error("Payment not found: ", err)
+		return nil, model.ErrPaymentNotFound
+	}
+
+	refunds, err := u.refundRepo.FindByPaymentID(ctx, paymentID)
+	if err != nil {
+		logrus.WithField("paymentID", paymentID).Error("Failed to get refunds: ", err)
+		return nil, err
+	}
+	return refunds, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+	pbProduct "github.com/tubagusmf/ecommerce-user-product-service/pb/product"
+)
+
+type ReservationUsecase struct {
+	reservationRepo model.IReservationRepository
+	transactor      model.ITransactor
+	productClient   pbProduct.ProductServiceClient
+}
+
+func NewReservationUsecase(
+	reservationRepo model.IReservationRepository,
+	transactor model.ITransactor,
+	productClient pbProduct.ProductServiceClient,
+) model.IReservationUsecase {
+	return &ReservationUsecase{
+		reservationRepo: reservationRepo,
+		transactor:      transactor,
+		productClient:   productClient,
+	}
+}
+
+// Reserve holds stock for every line of the cart for the configured TTL.
+// Either all lines are reserved or none are.
+func (u *ReservationUsecase) Reserve(ctx context.Context, cart *model.Cart) error {
+	log := logrus.WithFields(logrus.Fields{
+		"cartID": cart.ID,
+	})
+
+	items := make([]model.CartItem, len(cart.Items))
+	copy(items, cart.Items)
+	// Lock products in a stable order so concurrent checkouts cannot deadlock.
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ProductID < items[j].ProductID
+	})
+
+	now := time.Now()
+	expiresAt := now.Add(config.ReservationTTL())
+
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, item := range items {
+			if err := u.reservationRepo.LockProduct(ctx, item.ProductID); err != nil {
+				return err
+			}
+
+			res, err := u.productClient.GetProduct(ctx, &pbProduct.GetProductRequest{ProductId: item.ProductID})
+			if err != nil || res == nil || res.Product == nil {
+				return model.ErrInvalidProduct
+			}
+
+			reserved, err := u.reservationRepo.SumActiveQuantity(ctx, item.ProductID, now)
+			if err != nil {
+				return err
+			}
+
+			if res.Product.Stock-reserved < item.Quantity {
+				log.Warn("Not enough unreserved stock for product ", item.ProductID)
+				return model.ErrInsufficientStock
+			}
+
+			if err := u.reservationRepo.Create(ctx, &model.Reservation{
+				CartID:    cart.ID,
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				Status:    model.ReservationStatusActive,
+				ExpiresAt: expiresAt,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("Failed to reserve stock: ", err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *ReservationUsecase) AssignOrder(ctx context.Context, cartID int64, orderID string) error {
+	if err := u.reservationRepo.AssignOrder(ctx, cartID, orderID); err != nil {
+		logrus.WithField("cartID", cartID).Error("Failed to assign order to reservations: ", err)
+		return err
+	}
+	return nil
+}
+
+func (u *ReservationUsecase) Commit(ctx context.Context, orderID string) error {
+	n, err := u.reservationRepo.UpdateStatusByOrderID(ctx, orderID, model.ReservationStatusActive, model.ReservationStatusCommitted)
+	if err != nil {
+		logrus.WithField("orderID", orderID).Error("Failed to commit reservations: ", err)
+		return err
+	}
+
+	if n == 0 {
+		logrus.WithField("orderID", orderID).Warn("No active reservations to commit")
+	}
+	return nil
+}
+
+func (u *ReservationUsecase) Release(ctx context.Context, orderID string) error {
+	if _, err := u.reservationRepo.UpdateStatusByOrderID(ctx, orderID, model.ReservationStatusActive, model.ReservationStatusReleased); err != nil {
+		logrus.WithField("orderID", orderID).Error("Failed to release reservations: ", err)
+		return err
+	}
+	return nil
+}
+
+// Extend keeps the order's stock held until the given time, e.g. for as long
+// as an uncaptured authorization is valid.
+func (u *ReservationUsecase) Extend(ctx context.Context, orderID string, until time.Time) error {
+	if err := u.reservationRepo.ExtendByOrderID(ctx, orderID, until); err != nil {
+		logrus.WithField("orderID", orderID).Error("Failed to extend reservations: ", err)
+		return err
+	}
+	return nil
+}
+
+func (u *ReservationUsecase) ReleaseCart(ctx context.Context, cartID int64) error {
+	if _, err := u.reservationRepo.UpdateStatusByCartID(ctx, cartID, model.ReservationStatusActive, model.ReservationStatusReleased); err != nil {
+		logrus.WithField("cartID", cartID).Error("Failed to release reservations: ", err)
+		return err
+	}
+	return nil
+}
+
+// ReleaseExpired frees every active reservation past its expiry.
+func (u *ReservationUsecase) ReleaseExpired(ctx context.Context) (int64, error) {
+	n, err := u.reservationRepo.ReleaseExpired(ctx, time.Now())
+	if err != nil {
+		logrus.Error("Failed to release expired reservations: ", err)
+		return 0, err
+	}
+	return n, nil
+}
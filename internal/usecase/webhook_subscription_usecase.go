@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/helper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+// Headers sent with every merchant webhook. The signature is the hex encoded
+// HMAC-SHA256 of the body keyed with the subscription secret.
+const (
+	webhookEventHeader     = "X-Webhook-Event"
+	webhookEventIDHeader   = "X-Webhook-Id"
+	webhookDeliveryHeader  = "X-Webhook-Delivery"
+	webhookSignatureHeader = "X-Signature"
+)
+
+const webhookDeliveryLogLimit = 100
+
+type WebhookSubscriptionUsecase struct {
+	subscriptionRepo model.IWebhookSubscriptionRepository
+	deliveryRepo     model.IWebhookDeliveryRepository
+	httpClient       *http.Client
+}
+
+func NewWebhookSubscriptionUsecase(
+	subscriptionRepo model.IWebhookSubscriptionRepository,
+	deliveryRepo model.IWebhookDeliveryRepository,
+) model.IWebhookSubscriptionUsecase {
+	return &WebhookSubscriptionUsecase{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		httpClient:       &http.Client{Timeout: config.MerchantWebhookTimeout()},
+	}
+}
+
+// Subscribe registers a URL for one event type. The returned subscription
+// carries the signing secret; it is not shown again afterwards.
+func (u *WebhookSubscriptionUsecase) Subscribe(ctx context.Context, in model.CreateWebhookSubscription) (*model.WebhookSubscription, error) {
+	log := logrus.WithField("in", in)
+
+	if err := helper.Validator.Struct(in); err != nil {
+		log.Error("Validation error: ", err)
+		return nil, err
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		log.Error("Failed to generate webhook secret: ", err)
+		return nil, err
+	}
+
+	subscription := &model.WebhookSubscription{
+		EventType: in.EventType,
+		URL:       in.URL,
+		Secret:    secret,
+		Active:    true,
+	}
+	if err := u.subscriptionRepo.Create(ctx, subscription); err != nil {
+		log.Error("Failed to create webhook subscription: ", err)
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (u *WebhookSubscriptionUsecase) FindAll(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	subscriptions, err := u.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		logrus.Error("Failed to get webhook subscriptions: ", err)
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		subscription.Secret = ""
+	}
+	return subscriptions, nil
+}
+
+func (u *WebhookSubscriptionUsecase) Unsubscribe(ctx context.Context, id int64) error {
+	deactivated, err := u.subscriptionRepo.Deactivate(ctx, id)
+	if err != nil {
+		logrus.WithField("id", id).Error("Failed to deactivate webhook subscription: ", err)
+		return err
+	}
+	if !deactivated {
+		return model.ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// FindDeliveries returns the most recent deliveries to the subscription.
+func (u *WebhookSubscriptionUsecase) FindDeliveries(ctx context.Context, subscriptionID int64) ([]*model.WebhookDelivery, error) {
+	log := logrus.WithField("subscriptionID", subscriptionID)
+
+	subscription, err := u.subscriptionRepo.FindByID(ctx, subscriptionID)
+	if err != nil {
+		log.Error("Failed to get webhook subscription: ", err)
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, model.ErrWebhookSubscriptionNotFound
+	}
+
+	deliveries, err := u.deliveryRepo.FindBySubscriptionID(ctx, subscriptionID, webhookDeliveryLogLimit)
+	if err != nil {
+		log.Error("Failed to get webhook deliveries: ", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Redeliver queues a delivery to be sent again on the next run, whatever the
+// outcome of earlier attempts was.
+func (u *WebhookSubscriptionUsecase) Redeliver(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, error) {
+	log := logrus.WithField("deliveryID", deliveryID)
+
+	delivery, err := u.deliveryRepo.FindByID(ctx, deliveryID)
+	if err != nil {
+		log.Error("Failed to get webhook delivery: ", err)
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, model.ErrWebhookDeliveryNotFound
+	}
+
+	if err := u.deliveryRepo.Reset(ctx, deliveryID); err != nil {
+		log.Error("Failed to reset webhook delivery: ", err)
+		return nil, err
+	}
+	return u.deliveryRepo.FindByID(ctx, deliveryID)
+}
+
+// Dispatch queues the event for every active subscriber of its type.
+func (u *WebhookSubscriptionUsecase) Dispatch(ctx context.Context, eventType string, data interface{}) error {
+	subscriptions, err := u.subscriptionRepo.FindActiveByEventType(ctx, eventType)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	eventID, err := randomHex(16)
+	if err != nil {
+		return err
+	}
+	eventID = "evt_" + eventID
+
+	payload, err := json.Marshal(model.WebhookEnvelope{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        eventID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         model.WebhookDeliveryPending,
+			NextAttemptAt:  time.Now(),
+		})
+	}
+	return u.deliveryRepo.Create(ctx, deliveries)
+}
+
+// DeliverDue sends a batch of due deliveries and reports how many were
+// accepted. Failed attempts are retried with exponential backoff until the
+// attempt limit is reached.
+func (u *WebhookSubscriptionUsecase) DeliverDue(ctx context.Context) (int64, error) {
+	now := time.Now()
+	deliveries, err := u.deliveryRepo.ClaimDue(ctx, now, now.Add(2*u.httpClient.Timeout), config.MerchantWebhookBatchSize())
+	if err != nil {
+		logrus.Error("Failed to claim webhook deliveries: ", err)
+		return 0, err
+	}
+
+	subscriptions := make(map[int64]*model.WebhookSubscription)
+	var delivered int64
+	for _, delivery := range deliveries {
+		log := logrus.WithFields(logrus.Fields{
+			"deliveryID":     delivery.ID,
+			"subscriptionID": delivery.SubscriptionID,
+		})
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = u.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID)
+			if err != nil {
+				log.Error("Failed to get webhook subscription: ", err)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		u.attempt(ctx, subscription, delivery)
+		if err := u.deliveryRepo.SaveAttempt(ctx, delivery); err != nil {
+			log.Error("Failed to record webhook delivery attempt: ", err)
+			continue
+		}
+
+		if delivery.Status == model.WebhookDeliverySucceeded {
+			delivered++
+		} else {
+			log.Warn("Webhook delivery attempt ", delivery.Attempts, " failed: ", delivery.LastError)
+		}
+	}
+	return delivered, nil
+}
+
+// attempt posts the delivery once and updates it with the outcome.
+func (u *WebhookSubscriptionUsecase) attempt(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) {
+	delivery.Attempts++
+	delivery.LastResponseCode = 0
+
+	if subscription == nil || !subscription.Active {
+		delivery.Status = model.WebhookDeliveryFailed
+		delivery.LastError = "subscription is no longer active"
+		return
+	}
+
+	code, err := u.post(ctx, subscription, delivery)
+	delivery.LastResponseCode = code
+	if err == nil {
+		now := time.Now()
+		delivery.Status = model.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= config.MerchantWebhookMaxAttempts() {
+		delivery.Status = model.WebhookDeliveryFailed
+		return
+	}
+	delivery.Status = model.WebhookDeliveryPending
+	delivery.NextAttemptAt = time.Now().Add(helper.Backoff(delivery.Attempts, config.MerchantWebhookBackoff(), config.MerchantWebhookMaxBackoff()))
+}
+
+func (u *WebhookSubscriptionUsecase) post(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(subscription.Secret))
+	mac.Write(delivery.Payload)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, delivery.EventType)
+	req.Header.Set(webhookEventIDHeader, delivery.EventID)
+	req.Header.Set(webhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(webhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
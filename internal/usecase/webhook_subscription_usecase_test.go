@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type fakeWebhookSubscriptionRepo struct {
+	model.IWebhookSubscriptionRepository
+	subscriptions map[int64]*model.WebhookSubscription
+}
+
+func (r *fakeWebhookSubscriptionRepo) FindByID(ctx context.Context, id int64) (*model.WebhookSubscription, error) {
+	return r.subscriptions[id], nil
+}
+
+type fakeWebhookDeliveryRepo struct {
+	model.IWebhookDeliveryRepository
+	deliveries []*model.WebhookDelivery
+}
+
+func (r *fakeWebhookDeliveryRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	var due []*model.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == model.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) {
+			delivery.NextAttemptAt = leaseUntil
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeWebhookDeliveryRepo) SaveAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return nil
+}
+
+// webhookReceiver records the requests it gets and answers them with the
+// queued status codes, then with 200.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	codes    []int
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (h *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.requests = append(h.requests, receivedWebhook{header: r.Header.Clone(), body: body})
+
+	code := http.StatusOK
+	if len(h.codes) > 0 {
+		code, h.codes = h.codes[0], h.codes[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func newWebhookDeliveryTest(t *testing.T, receiver *webhookReceiver) (model.IWebhookSubscriptionUsecase, *model.WebhookSubscription, *model.WebhookDelivery) {
+	t.Helper()
+
+	viper.Set("merchant_webhook.timeout", "5s")
+	viper.Set("merchant_webhook.batch_size", 10)
+	viper.Set("merchant_webhook.max_attempts", 3)
+	viper.Set("merchant_webhook.backoff", "30s")
+	viper.Set("merchant_webhook.max_backoff", "1h")
+	t.Cleanup(viper.Reset)
+
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	subscription := &model.WebhookSubscription{
+		ID:        1,
+		EventType: model.EventPaymentSucceeded,
+		URL:       server.URL,
+		Secret:    "whsec_test",
+		Active:    true,
+	}
+	delivery := &model.WebhookDelivery{
+		ID:             10,
+		SubscriptionID: subscription.ID,
+		EventID:        "evt_1",
+		EventType:      model.EventPaymentSucceeded,
+		Payload:        []byte(`{"id":"evt_1","type":"payment.succeeded","data":{"id":1}}`),
+		Status:         model.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now().Add(-time.Second),
+	}
+
+	u := NewWebhookSubscriptionUsecase(
+		&fakeWebhookSubscriptionRepo{subscriptions: map[int64]*model.WebhookSubscription{subscription.ID: subscription}},
+		&fakeWebhookDeliveryRepo{deliveries: []*model.WebhookDelivery{delivery}},
+	)
+	return u, subscription, delivery
+}
+
+func TestDeliverDueSignsPayload(t *testing.T) {
+	receiver := &webhookReceiver{}
+	u, subscription, delivery := newWebhookDeliveryTest(t, receiver)
+
+	delivered, err := u.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("delivered = %d, want 1", delivered)
+	}
+	if len(receiver.requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(receiver.requests))
+	}
+
+	got := receiver.requests[0]
+	if string(got.body) != string(delivery.Payload) {
+		t.Errorf("body = %s, want %s", got.body, delivery.Payload)
+	}
+
+	mac := hmac.New(sha256.New, []byte(subscription.Secret))
+	mac.Write(got.body)
+	if want := hex.EncodeToString(mac.Sum(nil)); got.header.Get(webhookSignatureHeader) != want {
+		t.Errorf("%s = %q, want %q", webhookSignatureHeader, got.header.Get(webhookSignatureHeader), want)
+	}
+	if got.header.Get(webhookEventHeader) != delivery.EventType {
+		t.Errorf("%s = %q, want %q", webhookEventHeader, got.header.Get(webhookEventHeader), delivery.EventType)
+	}
+	if got.header.Get(webhookEventIDHeader) != delivery.EventID {
+		t.Errorf("%s = %q, want %q", webhookEventIDHeader, got.header.Get(webhookEventIDHeader), delivery.EventID)
+	}
+	if got.header.Get(webhookDeliveryHeader) != "10" {
+		t.Errorf("%s = %q, want %q", webhookDeliveryHeader, got.header.Get(webhookDeliveryHeader), "10")
+	}
+
+	if delivery.Status != model.WebhookDeliverySucceeded || delivery.DeliveredAt == nil {
+		t.Errorf("delivery status = %s, delivered at %v, want succeeded", delivery.Status, delivery.DeliveredAt)
+	}
+}
+
+func TestDeliverDueRetriesServerErrors(t *testing.T) {
+	receiver := &webhookReceiver{codes: []int{http.StatusServiceUnavailable}}
+	u, _, delivery := newWebhookDeliveryTest(t, receiver)
+
+	delivered, err := u.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delivered != 0 {
+		t.Fatalf("delivered = %d, want 0", delivered)
+	}
+	if delivery.Status != model.WebhookDeliveryPending {
+		t.Fatalf("delivery status = %s, want pending", delivery.Status)
+	}
+	if delivery.LastResponseCode != http.StatusServiceUnavailable {
+		t.Errorf("last response code = %d, want %d", delivery.LastResponseCode, http.StatusServiceUnavailable)
+	}
+	if !delivery.NextAttemptAt.After(time.Now()) {
+		t.Errorf("next attempt at %v is not in the future", delivery.NextAttemptAt)
+	}
+
+	// Nothing is sent again before the backoff has passed.
+	if _, err := u.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(receiver.requests) != 1 {
+		t.Fatalf("receiver got %d requests before the backoff passed, want 1", len(receiver.requests))
+	}
+
+	delivery.NextAttemptAt = time.Now().Add(-time.Second)
+	delivered, err = u.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("delivered = %d on retry, want 1", delivered)
+	}
+	if len(receiver.requests) != 2 {
+		t.Fatalf("receiver got %d requests, want 2", len(receiver.requests))
+	}
+	if delivery.Attempts != 2 || delivery.Status != model.WebhookDeliverySucceeded {
+		t.Errorf("delivery attempts = %d, status = %s, want 2 and succeeded", delivery.Attempts, delivery.Status)
+	}
+}
+
+func TestDeliverDueGivesUpAfterMaxAttempts(t *testing.T) {
+	receiver := &webhookReceiver{codes: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable}}
+	u, _, delivery := newWebhookDeliveryTest(t, receiver)
+
+	for i := 0; i < 3; i++ {
+		delivery.NextAttemptAt = time.Now().Add(-time.Second)
+		if _, err := u.DeliverDue(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if delivery.Status != model.WebhookDeliveryFailed {
+		t.Errorf("delivery status = %s, want failed", delivery.Status)
+	}
+	if delivery.Attempts != 3 {
+		t.Errorf("delivery attempts = %d, want 3", delivery.Attempts)
+	}
+}
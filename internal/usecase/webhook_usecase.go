@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/config"
+	"github.com/tubagusmf/ecommerce-payment-cart-service/internal/model"
+)
+
+type WebhookUsecase struct {
+	webhookRepo    model.IWebhookRepository
+	paymentUsecase model.IPaymentUsecase
+	providers      model.IProviderRegistry
+}
+
+func NewWebhookUsecase(
+	webhookRepo model.IWebhookRepository,
+	paymentUsecase model.IPaymentUsecase,
+	providers model.IProviderRegistry,
+) model.IWebhookUsecase {
+	return &WebhookUsecase{
+		webhookRepo:    webhookRepo,
+		paymentUsecase: paymentUsecase,
+		providers:      providers,
+	}
+}
+
+// Receive verifies, stores and applies a provider callback. The signature is
+// the hex encoded HMAC-SHA256 of the raw payload keyed with the provider's
+// configured secret. Redeliveries of an event that was already handled are
+// returned with Duplicate set and not applied again.
+func (u *WebhookUsecase) Receive(ctx context.Context, providerName string, payload []byte, signature string) (*model.WebhookEvent, error) {
+	log := logrus.WithField("provider", providerName)
+
+	parser, secret, err := u.webhookProvider(providerName)
+	if err != nil {
+		log.Error("Webhook for unknown provider: ", err)
+		return nil, err
+	}
+
+	if !validSignature(secret, payload, signature) {
+		log.Warn("Rejected webhook with invalid signature")
+		u.storeRejected(ctx, providerName, payload, signature, model.ErrWebhookSignatureInvalid)
+		return nil, model.ErrWebhookSignatureInvalid
+	}
+
+	notification, err := parser.ParseWebhook(payload)
+	if err != nil {
+		if !errors.Is(err, model.ErrWebhookPayloadInvalid) {
+			err = fmt.Errorf("%w: %v", model.ErrWebhookPayloadInvalid, err)
+		}
+		log.Error("Failed to parse webhook: ", err)
+		u.storeRejected(ctx, providerName, payload, signature, err)
+		return nil, err
+	}
+
+	event := &model.WebhookEvent{
+		Provider:  providerName,
+		EventID:   notification.EventID,
+		Reference: notification.Reference,
+		Status:    model.WebhookEventReceived,
+		Signature: signature,
+		Payload:   string(payload),
+	}
+	created, err := u.webhookRepo.Create(ctx, event)
+	if err != nil {
+		log.Error("Failed to store webhook event: ", err)
+		return nil, err
+	}
+
+	if !created {
+		existing, err := u.webhookRepo.FindByEventID(ctx, providerName, notification.EventID)
+		if err != nil {
+			log.Error("Failed to load webhook event: ", err)
+			return nil, err
+		}
+		if existing == nil {
+			return nil, model.ErrWebhookEventNotFound
+		}
+		if existing.Status == model.WebhookEventProcessed || existing.Status == model.WebhookEventIgnored {
+			existing.Duplicate = true
+			return existing, nil
+		}
+		// An earlier delivery failed; let the provider's retry have a go.
+		event = existing
+	}
+
+	return u.process(ctx, event, notification)
+}
+
+// Replay applies a stored event again, e.g. after the cause of a failure was
+// fixed. Rejected events are never replayed.
+func (u *WebhookUsecase) Replay(ctx context.Context, id int64) (*model.WebhookEvent, error) {
+	log := logrus.WithField("webhookEventID", id)
+
+	event, err := u.webhookRepo.FindByID(ctx, id)
+	if err != nil {
+		log.Error("Failed to load webhook event: ", err)
+		return nil, err
+	}
+	if event == nil {
+		return nil, model.ErrWebhookEventNotFound
+	}
+	if event.Status == model.WebhookEventRejected {
+		return nil, model.ErrWebhookEventNotReplayable
+	}
+
+	parser, _, err := u.webhookProvider(event.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := parser.ParseWebhook([]byte(event.Payload))
+	if err != nil {
+		log.Error("Failed to parse stored webhook: ", err)
+		return nil, err
+	}
+
+	return u.process(ctx, event, notification)
+}
+
+// process applies the notification and records the outcome on the event.
+// Updates the payment state machine refuses, typically because the payment
+// already settled another way, and transfers of the wrong amount are
+// recorded as ignored. Other failures are recorded and returned so the
+// provider retries the delivery.
+func (u *WebhookUsecase) process(ctx context.Context, event *model.WebhookEvent, notification *model.WebhookNotification) (*model.WebhookEvent, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"provider": event.Provider,
+		"eventID":  event.EventID,
+	})
+
+	var err error
+	if notification.VirtualAccount != "" {
+		_, err = u.paymentUsecase.ApplyVirtualAccountTransfer(ctx, notification.VirtualAccount, notification.Amount)
+	} else {
+		_, err = u.paymentUsecase.ApplyProviderUpdate(ctx, notification.Reference, notification.Status)
+	}
+
+	status, errMsg := model.WebhookEventProcessed, ""
+	switch {
+	case err == nil:
+	case errors.Is(err, model.ErrInvalidPaymentTransition), errors.Is(err, model.ErrTransferAmountMismatch):
+		log.Warn("Ignoring webhook: ", err)
+		status, errMsg = model.WebhookEventIgnored, err.Error()
+		err = nil
+	default:
+		log.Error("Failed to apply webhook: ", err)
+		status, errMsg = model.WebhookEventFailed, err.Error()
+	}
+
+	if updateErr := u.webhookRepo.UpdateResult(ctx, event.ID, status, errMsg); updateErr != nil {
+		log.Error("Failed to record webhook result: ", updateErr)
+		if err == nil {
+			err = updateErr
+		}
+	}
+
+	event.Status = status
+	event.Error = errMsg
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (u *WebhookUsecase) webhookProvider(name string) (model.WebhookProvider, string, error) {
+	secret := config.WebhookSecret(name)
+	if secret == "" || !u.providers.Has(name) {
+		return nil, "", model.ErrWebhookProviderUnknown
+	}
+
+	provider, err := u.providers.Get(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	parser, ok := provider.(model.WebhookProvider)
+	if !ok {
+		return nil, "", model.ErrWebhookProviderUnknown
+	}
+	return parser, secret, nil
+}
+
+func (u *WebhookUsecase) storeRejected(ctx context.Context, provider string, payload []byte, signature string, reason error) {
+	event := &model.WebhookEvent{
+		Provider:  provider,
+		Status:    model.WebhookEventRejected,
+		Signature: signature,
+		Payload:   string(payload),
+		Error:     reason.Error(),
+	}
+	if _, err := u.webhookRepo.Create(ctx, event); err != nil {
+		logrus.WithField("provider", provider).Error("Failed to store rejected webhook: ", err)
+	}
+}
+
+func validSignature(secret string, payload []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}